@@ -6,6 +6,7 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"sync"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
@@ -15,12 +16,19 @@ import (
 	httptransport "github.com/go-openapi/runtime/client"
 )
 
+// disableCertificateChecks makes sure the default transport is only modified once,
+// so that API clients can be created while other requests are in flight.
+var disableCertificateChecks sync.Once
+
 // ApiClient provides a new API client used to communicate with the REANA server.
+// It is safe to call ApiClient from multiple goroutines.
 func ApiClient() (*API, error) {
 	// disable certificate security checks
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{
-		InsecureSkipVerify: true,
-	}
+	disableCertificateChecks.Do(func() {
+		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true,
+		}
+	})
 
 	// parse REANA server URL
 	serverURL := viper.GetString("server-url")
@@ -0,0 +1,275 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/datautils"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/errorhandler"
+	"reanahub/reana-client-go/pkg/workflows"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const bulkFilterFlagDesc = `Act on all the workflows that match certain filtering
+criteria instead of a single workflow. Use --filter
+<columm_name>=<column_value> pairs. Available
+filters are 'name' and 'status'.`
+
+// bulkSelector holds the flags used to select several workflows at once, instead of using --workflow.
+type bulkSelector struct {
+	filters    []string
+	olderThan  string
+	namePrefix string
+	yes        bool
+}
+
+// bulkTarget represents a workflow selected by a bulkSelector.
+type bulkTarget struct {
+	name    string
+	status  string
+	created string
+}
+
+// bulkResult represents the outcome of a bulk action on a single workflow.
+type bulkResult struct {
+	workflow string
+	message  string
+	err      error
+}
+
+// addFlags adds the selection flags to the given command flags, annotating the workflow flag
+// so that it is not required when the selection flags are used.
+func (s *bulkSelector) addFlags(f *pflag.FlagSet) {
+	f.StringSliceVar(&s.filters, "filter", []string{}, bulkFilterFlagDesc)
+	f.StringVar(
+		&s.olderThan,
+		"older-than",
+		"",
+		`Act on the workflows created before the given
+duration, e.g. 12h, 30d or 2w.`,
+	)
+	f.StringVar(
+		&s.namePrefix,
+		"name-prefix",
+		"",
+		"Act on the workflows whose name starts with the given prefix.",
+	)
+	f.BoolVarP(
+		&s.yes,
+		"yes",
+		"y",
+		false,
+		"Do not ask for confirmation when acting on several workflows.",
+	)
+
+	err := f.SetAnnotation("workflow", "properties", []string{"selectable"})
+	if err != nil {
+		log.Debugf("Failed to set workflow annotation: %s", err.Error())
+	}
+}
+
+// isBulkSelection returns true if any of the selection flags has been set in the given flags.
+func isBulkSelection(f *pflag.FlagSet) bool {
+	for _, name := range config.BulkSelectorFlags {
+		if f.Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// validate verifies that the selection flags are not mixed with an explicit --workflow flag.
+func (s *bulkSelector) validate(f *pflag.FlagSet) error {
+	if f.Changed("workflow") && isBulkSelection(f) {
+		return fmt.Errorf(
+			"please provide either --workflow or one of --%s, not both",
+			strings.Join(config.BulkSelectorFlags, ", --"),
+		)
+	}
+	if s.olderThan != "" {
+		if _, err := datautils.ParseDuration(s.olderThan); err != nil {
+			return fmt.Errorf("invalid value for '--older-than': %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// resolve returns the workflows that match the selection flags.
+// defaultStatuses are the statuses considered when no status filter is provided;
+// if empty, all the statuses except deleted are considered.
+func (s *bulkSelector) resolve(
+	token string,
+	defaultStatuses []string,
+) ([]bulkTarget, error) {
+	statusFilters, searchFilter, filters, err := parseListFilters(s.filters, false, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(defaultStatuses) > 0 && !hasStatusFilter(s.filters) {
+		statusFilters = defaultStatuses
+	}
+
+	var createdBefore time.Time
+	if s.olderThan != "" {
+		olderThan, err := datautils.ParseDuration(s.olderThan)
+		if err != nil {
+			return nil, err
+		}
+		createdBefore = time.Now().UTC().Add(-olderThan)
+	}
+
+	listParams := operations.NewGetWorkflowsParams()
+	listParams.SetAccessToken(&token)
+	listParams.SetType("batch")
+	listParams.SetStatus(statusFilters)
+	listParams.SetSearch(&searchFilter)
+
+	api, err := client.ApiClient()
+	if err != nil {
+		return nil, err
+	}
+	listResp, err := api.Operations.GetWorkflows(listParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []bulkTarget
+	for _, workflow := range listResp.Payload.Items {
+		name, _ := workflows.GetNameAndRunNumber(workflow.Name)
+		if !strings.HasPrefix(name, s.namePrefix) || !filters.Match(workflowFilterValues(workflow)) {
+			continue
+		}
+		if !createdBefore.IsZero() {
+			created, err := datautils.FromIsoToTimestamp(workflow.Created)
+			if err != nil {
+				return nil, err
+			}
+			if !created.Before(createdBefore) {
+				continue
+			}
+		}
+		targets = append(targets, bulkTarget{
+			name:    workflow.Name,
+			status:  workflow.Status,
+			created: workflow.Created,
+		})
+	}
+	return targets, nil
+}
+
+// hasStatusFilter returns true if any of the given filters is a status filter.
+func hasStatusFilter(filters []string) bool {
+	for _, filter := range filters {
+		key, _, err := datautils.SplitKeyValue(filter)
+		if err == nil && strings.ToLower(key) == "status" {
+			return true
+		}
+	}
+	return false
+}
+
+// runBulkAction displays the selected workflows and runs the action on each of them,
+// asking for confirmation unless --yes was given.
+// The action returns the message to be displayed for the workflow when it succeeds.
+func (s *bulkSelector) runBulkAction(
+	cmd *cobra.Command,
+	targets []bulkTarget,
+	verb string,
+	action func(workflow string) (string, error),
+) error {
+	out := cmd.OutOrStdout()
+	if len(targets) == 0 {
+		displayer.DisplayMessage(
+			"No workflows match the given selection.",
+			displayer.Info,
+			false,
+			out,
+		)
+		return nil
+	}
+
+	var rows [][]string
+	names := make([]string, len(targets))
+	for i, target := range targets {
+		rows = append(rows, []string{target.name, target.status, target.created})
+		names[i] = target.name
+	}
+	displayer.DisplayTable([]string{"name", "status", "created"}, rows, out)
+
+	return confirmAndRunBulkAction(cmd, names, verb, s.yes, action)
+}
+
+// confirmAndRunBulkAction asks for confirmation unless skipConfirmation is set, runs the action
+// concurrently on each of the given workflows and displays a table with the results.
+func confirmAndRunBulkAction(
+	cmd *cobra.Command,
+	names []string,
+	verb string,
+	skipConfirmation bool,
+	action func(workflow string) (string, error),
+) error {
+	out := cmd.OutOrStdout()
+	if !skipConfirmation {
+		question := fmt.Sprintf("Do you want to %s %d workflow(s)?", verb, len(names))
+		if !displayer.AskConfirmation(question, cmd.InOrStdin(), out) {
+			return errors.New("operation aborted")
+		}
+	}
+
+	results := make([]bulkResult, len(names))
+	semaphore := make(chan struct{}, config.BulkConcurrency)
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, workflow string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			log.Infof("Running %s on workflow %s", verb, workflow)
+			message, err := action(workflow)
+			results[i] = bulkResult{workflow: workflow, message: message, err: err}
+		}(i, name)
+	}
+	wg.Wait()
+
+	failed := 0
+	var rows [][]string
+	for _, result := range results {
+		message := result.message
+		if result.err != nil {
+			failed++
+			message = "failed: " + errorhandler.HandleApiError(result.err).Error()
+		}
+		rows = append(rows, []string{result.workflow, message})
+	}
+	displayer.DisplayTable([]string{"workflow", "result"}, rows, out)
+
+	if failed > 0 {
+		displayer.DisplayMessage(
+			fmt.Sprintf("Failed to %s %d out of %d workflow(s).", verb, failed, len(names)),
+			displayer.Error,
+			false,
+			out,
+		)
+		return config.ErrEmpty
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"testing"
+)
+
+func TestHasStatusFilter(t *testing.T) {
+	tests := map[string]struct {
+		filters []string
+		want    bool
+	}{
+		"no filters":       {filters: []string{}, want: false},
+		"name filter":      {filters: []string{"name=test"}, want: false},
+		"status filter":    {filters: []string{"name=test", "status=failed"}, want: true},
+		"upper case key":   {filters: []string{"STATUS=failed"}, want: true},
+		"malformed filter": {filters: []string{"status"}, want: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := hasStatusFilter(test.filters)
+			if got != test.want {
+				t.Errorf("Expected %t, got %t", test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/cache"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/datautils"
+	"reanahub/reana-client-go/pkg/sessiontypes"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// pingInfo is the part of the ping response that is cached.
+type pingInfo struct {
+	Email         string `json:"email"`
+	ServerVersion string `json:"reana_server_version"`
+}
+
+// getServerInfo returns the general information of the server, from the cache if it is recent enough.
+// If refresh is set, the information is always retrieved from the server.
+func getServerInfo(
+	api *client.API,
+	token string,
+	refresh bool,
+) (*operations.InfoOKBody, error) {
+	key := "info:" + viper.GetString("server-url")
+	info := &operations.InfoOKBody{}
+	if !refresh && cache.Load(key, config.ServerInfoCacheTTL, info) {
+		log.Debugf("Using cached server info for %s", viper.GetString("server-url"))
+		return info, nil
+	}
+
+	infoParams := operations.NewInfoParams()
+	infoParams.SetAccessToken(token)
+	infoResp, err := api.Operations.Info(infoParams)
+	if err != nil {
+		return nil, err
+	}
+	if err := cache.Store(key, infoResp.Payload); err != nil {
+		log.Debugf("Could not cache server info: %v", err)
+	}
+	return infoResp.Payload, nil
+}
+
+// getServerConfig returns the configuration of the server, from the cache if it is recent enough.
+// If refresh is set, the configuration is always retrieved from the server.
+func getServerConfig(api *client.API, token string, refresh bool) (any, error) {
+	key := "config:" + viper.GetString("server-url")
+	var serverConfig any
+	if !refresh && cache.Load(key, config.ServerInfoCacheTTL, &serverConfig) {
+		log.Debugf("Using cached server configuration for %s", viper.GetString("server-url"))
+		return serverConfig, nil
+	}
+
+	configParams := operations.NewGetConfigParams()
+	configParams.SetAccessToken(&token)
+	configResp, err := api.Operations.GetConfig(configParams)
+	if err != nil {
+		return nil, err
+	}
+	if err := cache.Store(key, configResp.Payload); err != nil {
+		log.Debugf("Could not cache server configuration: %v", err)
+	}
+	return configResp.Payload, nil
+}
+
+// getSessionTypes returns the types of interactive sessions supported by the server.
+// The recommended Jupyter images are taken from the server info when the configuration does not list them.
+// If the server does not advertise its types, only the default one is returned.
+func getSessionTypes(
+	api *client.API,
+	token string,
+	info *operations.InfoOKBody,
+) []sessiontypes.Type {
+	serverConfig, err := getServerConfig(api, token, false)
+	if err != nil {
+		log.Debugf("Could not retrieve the server configuration: %v", err)
+	}
+	types, err := sessiontypes.Discover(serverConfig)
+	if err != nil {
+		log.Debugf("Could not discover the interactive session types: %v", err)
+		types, _ = sessiontypes.Discover(nil)
+	}
+
+	for i, sessionType := range types {
+		if sessionType.Name == "jupyter" && len(sessionType.RecommendedImages) == 0 &&
+			info != nil && info.InteractiveSessionRecommendedJupyterImages != nil {
+			types[i].RecommendedImages = info.InteractiveSessionRecommendedJupyterImages.Value
+		}
+	}
+	return types
+}
+
+// getPingInfo returns the server version and the email of the user, from the cache if it is recent enough.
+// If refresh is set, the information is always retrieved from the server.
+func getPingInfo(api *client.API, token string, refresh bool) (pingInfo, error) {
+	key := "ping:" + viper.GetString("server-url") + ":" + token
+	var info pingInfo
+	if !refresh && cache.Load(key, config.ServerInfoCacheTTL, &info) {
+		log.Debugf("Using cached server version for %s", viper.GetString("server-url"))
+		return info, nil
+	}
+
+	pingParams := operations.NewGetYouParams()
+	pingParams.SetAccessToken(&token)
+	pingResp, err := api.Operations.GetYou(pingParams)
+	if err != nil {
+		return info, err
+	}
+	info = pingInfo{
+		Email:         pingResp.Payload.Email,
+		ServerVersion: pingResp.Payload.ReanaServerVersion,
+	}
+	if err := cache.Store(key, info); err != nil {
+		log.Debugf("Could not cache server version: %v", err)
+	}
+	return info, nil
+}
+
+// requireServerFeature returns an error if the server version is older than the one supporting the feature,
+// as listed in config.ServerFeatureVersions. The usage describes what needs the feature, e.g. a flag.
+// If the server version cannot be determined, the feature is assumed to be supported.
+func requireServerFeature(api *client.API, token, feature, usage string) error {
+	minVersion, exists := config.ServerFeatureVersions[feature]
+	if !exists {
+		return nil
+	}
+	info, err := getPingInfo(api, token, false)
+	if err != nil || info.ServerVersion == "" {
+		log.Debugf("Could not determine the server version, assuming %s is supported: %v", feature, err)
+		return nil
+	}
+	if datautils.CompareVersions(info.ServerVersion, minVersion) < 0 {
+		return fmt.Errorf(
+			"%s is not supported by server %s, it requires REANA %s or newer",
+			usage,
+			datautils.ShortVersion(info.ServerVersion),
+			datautils.ShortVersion(minVersion),
+		)
+	}
+	return nil
+}
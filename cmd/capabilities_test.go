@@ -0,0 +1,130 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reanahub/reana-client-go/client"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// newCountingServer starts a server answering the given paths with the given response files,
+// counting the requests made to each path.
+func newCountingServer(t *testing.T, responses map[string]string) map[string]int {
+	calls := map[string]int{}
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			responseFile, ok := responses[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			calls[r.URL.Path]++
+			body, err := os.ReadFile("../testdata/inputs/" + responseFile)
+			if err != nil {
+				t.Errorf("Error while reading response file: %v", err)
+			}
+			w.Header().Add("Content-Type", "application/json")
+			_, _ = w.Write(body)
+		}),
+	)
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	viper.Set("server-url", server.URL)
+	t.Cleanup(func() {
+		server.Close()
+		viper.Reset()
+	})
+	return calls
+}
+
+func TestGetServerInfoCache(t *testing.T) {
+	calls := newCountingServer(t, map[string]string{infoServerPath: "info_big.json"})
+	api, err := client.ApiClient()
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+
+	for i := 0; i < 2; i++ {
+		info, err := getServerInfo(api, "1234", false)
+		if err != nil {
+			t.Fatalf("Got unexpected error: %s", err.Error())
+		}
+		if info.KubernetesMaxCPULimit == nil || *info.KubernetesMaxCPULimit.Value != "4" {
+			t.Errorf("Expected cached info to be complete, got %v", info.KubernetesMaxCPULimit)
+		}
+	}
+	if calls[infoServerPath] != 1 {
+		t.Errorf("Expected 1 request to the server, got %d", calls[infoServerPath])
+	}
+
+	if _, err := getServerInfo(api, "1234", true); err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+	if calls[infoServerPath] != 2 {
+		t.Errorf("Expected refresh to request the server, got %d requests", calls[infoServerPath])
+	}
+}
+
+func TestRequireServerFeature(t *testing.T) {
+	tests := map[string]struct {
+		pingFile  string
+		feature   string
+		wantError string
+	}{
+		"supported": {
+			pingFile: "ping_latest.json",
+			feature:  "sharing",
+		},
+		"not supported": {
+			pingFile:  "ping.json",
+			feature:   "sharing",
+			wantError: "share-add is not supported by server 0.9, it requires REANA 0.95 or newer",
+		},
+		"unknown feature": {
+			pingFile: "ping.json",
+			feature:  "unknown",
+		},
+		"unknown server version": {
+			feature: "sharing",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			responses := map[string]string{}
+			if test.pingFile != "" {
+				responses[pingServerPath] = test.pingFile
+			}
+			calls := newCountingServer(t, responses)
+			api, err := client.ApiClient()
+			if err != nil {
+				t.Fatalf("Got unexpected error: %s", err.Error())
+			}
+
+			for i := 0; i < 2; i++ {
+				err = requireServerFeature(api, "1234", test.feature, "share-add")
+				if test.wantError == "" && err != nil {
+					t.Errorf("Got unexpected error: %s", err.Error())
+				}
+				if test.wantError != "" &&
+					(err == nil || !strings.Contains(err.Error(), test.wantError)) {
+					t.Errorf("Expected error '%s', got %v", test.wantError, err)
+				}
+			}
+			if test.pingFile != "" && test.feature != "unknown" && calls[pingServerPath] != 1 {
+				t.Errorf("Expected server version to be cached, got %d requests", calls[pingServerPath])
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/cleanup"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/datautils"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/validator"
+	"reanahub/reana-client-go/pkg/workflows"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const cleanupDesc = `
+Clean up workspaces according to a policy.
+
+The ` + "``cleanup``" + ` command evaluates the rules of a cleanup policy file against
+your workflows and deletes or prunes the selected workspaces. By default, only
+the plan is displayed, together with the disk space that would be reclaimed.
+Use ` + "``--apply``" + ` to execute it.
+
+The policy file is a YAML file with an ordered list of rules. Each rule has an
+action (` + "``delete``" + ` or ` + "``prune``" + `) and one or more criteria that must all be
+satisfied: ` + "``name_prefix``" + `, ` + "``status``" + `, ` + "``older_than``" + `, ` + "``size_over``" + ` and
+` + "``keep_last``" + `. Prune rules can also set ` + "``include_inputs``" + ` and
+` + "``include_outputs``" + `. Workflows that are pending, queued or running are never
+selected. For example:
+
+  rules:
+    - name: keep-last-5
+      action: delete
+      keep_last: 5
+    - name: old-failed-runs
+      action: delete
+      status: [failed]
+      older_than: 14d
+    - name: big-workspaces
+      action: prune
+      size_over: 50GiB
+
+Examples:
+
+  $ reana-client cleanup --policy cleanup.yaml
+
+  $ reana-client cleanup --policy cleanup.yaml --bytes
+
+  $ reana-client cleanup --policy cleanup.yaml --apply --yes
+`
+
+type cleanupOptions struct {
+	token  string
+	policy string
+	apply  bool
+	yes    bool
+	sizeOptions
+}
+
+// newCleanupCmd creates a command to clean up workspaces according to a policy.
+func newCleanupCmd() *cobra.Command {
+	o := &cleanupOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "cleanup",
+		Short: "Clean up workspaces according to a policy.",
+		Long:  cleanupDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validator.ValidateFile(o.policy); err != nil {
+				return fmt.Errorf("invalid value for '--policy': %s", err.Error())
+			}
+			if err := o.validateSizeFlags(); err != nil {
+				return err
+			}
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(
+		&o.token,
+		"access-token",
+		"t",
+		"",
+		"Access token of the current user.",
+	)
+	f.StringVarP(
+		&o.policy,
+		"policy",
+		"p",
+		"reana-cleanup.yaml",
+		"Cleanup policy file describing which workspaces to clean up.",
+	)
+	f.BoolVar(
+		&o.apply,
+		"apply",
+		false,
+		"Execute the cleanup plan instead of only displaying it.",
+	)
+	f.BoolVarP(
+		&o.yes,
+		"yes",
+		"y",
+		false,
+		"Do not ask for confirmation when applying the cleanup plan.",
+	)
+	addSizeFlags(
+		f,
+		&o.sizeOptions,
+		"Show disk sizes in human readable format, which is the default.",
+		true,
+	)
+	// Remove -h shorthand
+	cmd.PersistentFlags().BoolP("help", "", false, "Help for cleanup")
+
+	return cmd
+}
+
+func (o *cleanupOptions) run(cmd *cobra.Command) error {
+	policy, err := cleanup.LoadPolicy(o.policy)
+	if err != nil {
+		return err
+	}
+
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	runs, err := o.getWorkflows(api)
+	if err != nil {
+		return err
+	}
+
+	actions := policy.Plan(runs, time.Now().UTC())
+	out := cmd.OutOrStdout()
+	if len(actions) == 0 {
+		displayer.DisplayMessage(
+			"Nothing to clean up according to the policy.",
+			displayer.Info,
+			false,
+			out,
+		)
+		return nil
+	}
+	displayCleanupPlan(cmd, actions, o.sizeOptions)
+
+	if !o.apply {
+		displayer.DisplayMessage(
+			"This is a dry run, use --apply to clean up the workspaces.",
+			displayer.Info,
+			false,
+			out,
+		)
+		return nil
+	}
+
+	actionsByWorkflow := make(map[string]cleanup.PlannedAction)
+	names := make([]string, len(actions))
+	for i, action := range actions {
+		actionsByWorkflow[action.Workflow.FullName] = action
+		names[i] = action.Workflow.FullName
+	}
+	return confirmAndRunBulkAction(
+		cmd,
+		names,
+		"clean up",
+		o.yes,
+		func(workflow string) (string, error) {
+			return o.applyAction(api, actionsByWorkflow[workflow])
+		},
+	)
+}
+
+// getWorkflows retrieves all the workflows of the user, including their workspace size.
+func (o *cleanupOptions) getWorkflows(api *client.API) ([]cleanup.Workflow, error) {
+	includeWorkspaceSize := true
+	listParams := operations.NewGetWorkflowsParams()
+	listParams.SetAccessToken(&o.token)
+	listParams.SetType("batch")
+	listParams.SetStatus(config.GetRunStatuses(false))
+	listParams.SetIncludeWorkspaceSize(&includeWorkspaceSize)
+	listResp, err := api.Operations.GetWorkflows(listParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var runs []cleanup.Workflow
+	for _, workflow := range listResp.Payload.Items {
+		created, err := datautils.FromIsoToTimestamp(workflow.Created)
+		if err != nil {
+			return nil, err
+		}
+		name, _ := workflows.GetNameAndRunNumber(workflow.Name)
+		run := cleanup.Workflow{
+			Name:     name,
+			FullName: workflow.Name,
+			Status:   workflow.Status,
+			Created:  created,
+		}
+		if workflow.Size != nil {
+			run.Size = workflow.Size.Raw
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
+// applyAction deletes or prunes the workspace of a workflow, according to the planned action.
+func (o *cleanupOptions) applyAction(
+	api *client.API,
+	action cleanup.PlannedAction,
+) (string, error) {
+	workflow := action.Workflow.FullName
+	if action.Rule.Action == cleanup.DeleteAction {
+		err := workflows.UpdateStatus(o.token, workflow, "deleted", true, false)
+		if err != nil {
+			return "", err
+		}
+		return "deleted", nil
+	}
+
+	pruneParams := operations.NewPruneWorkspaceParams()
+	pruneParams.SetAccessToken(&o.token)
+	pruneParams.SetWorkflowIDOrName(workflow)
+	pruneParams.SetIncludeInputs(&action.Rule.IncludeInputs)
+	pruneParams.SetIncludeOutputs(&action.Rule.IncludeOutputs)
+	_, err := api.Operations.PruneWorkspace(pruneParams)
+	if err != nil {
+		return "", err
+	}
+	return "pruned", nil
+}
+
+// displayCleanupPlan displays the planned actions and a summary of the reclaimed disk space.
+func displayCleanupPlan(cmd *cobra.Command, actions []cleanup.PlannedAction, sizes sizeOptions) {
+	header := []string{"workflow", "status", "created", "size", "action", "rule"}
+	var rows [][]string
+	toDelete, toPrune := 0, 0
+	for _, action := range actions {
+		if action.Rule.Action == cleanup.DeleteAction {
+			toDelete++
+		} else {
+			toPrune++
+		}
+		rows = append(rows, []string{
+			action.Workflow.FullName,
+			action.Workflow.Status,
+			action.Workflow.Created.Format("2006-01-02T15:04:05"),
+			sizes.formatSize(action.ReclaimedBytes),
+			action.Rule.Action,
+			action.Rule.Name,
+		})
+	}
+	displayer.DisplayTable(header, rows, cmd.OutOrStdout())
+
+	reclaimed := sizes.formatSize(cleanup.ReclaimedBytes(actions))
+	if !sizes.showHumanReadable() {
+		reclaimed += " bytes"
+	}
+	if toPrune > 0 {
+		reclaimed = "up to " + reclaimed
+	}
+	displayer.DisplayMessage(
+		fmt.Sprintf(
+			"%d workflow(s) to delete and %d to prune, reclaiming %s.",
+			toDelete,
+			toPrune,
+			reclaimed,
+		),
+		displayer.Info,
+		false,
+		cmd.OutOrStdout(),
+	)
+}
@@ -0,0 +1,156 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+)
+
+const deletePolicy = `
+rules:
+  - name: keep-last-2
+    action: delete
+    keep_last: 2
+  - name: old-failed
+    action: delete
+    status: [failed]
+    older_than: 14d
+  - name: big-workspaces
+    action: prune
+    size_over: 50GiB
+`
+
+const prunePolicy = `
+rules:
+  - action: prune
+    name_prefix: analysis
+    size_over: 1KiB
+    include_outputs: true
+`
+
+func TestCleanup(t *testing.T) {
+	tempDir := t.TempDir()
+	deletePolicyFile := tempDir + "/delete.yaml"
+	prunePolicyFile := tempDir + "/prune.yaml"
+	invalidPolicyFile := tempDir + "/invalid.yaml"
+	files := map[string]string{
+		deletePolicyFile:  deletePolicy,
+		prunePolicyFile:   prunePolicy,
+		invalidPolicyFile: "rules:\n  - action: archive\n    keep_last: 1\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+			t.Fatalf("Error while creating test file: %s", err.Error())
+		}
+	}
+
+	tests := map[string]TestCmdParams{
+		"dry run": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "cleanup_list.json",
+				},
+			},
+			args: []string{"--policy", deletePolicyFile},
+			expected: []string{
+				"WORKFLOW", "STATUS", "CREATED", "SIZE", "ACTION", "RULE",
+				"analysis.1", "60 GiB", "keep-last-2",
+				"test-run.1", "4 KiB", "old-failed",
+				"2 workflow(s) to delete and 0 to prune, reclaiming 60 GiB.",
+				"This is a dry run",
+			},
+			unwanted: []string{"analysis.2", "analysis.3", "test-run.2", "big-workspaces"},
+		},
+		"dry run in bytes": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "cleanup_list.json",
+				},
+			},
+			args: []string{"--policy", deletePolicyFile, "--bytes"},
+			expected: []string{
+				"64424509440",
+				"2 workflow(s) to delete and 0 to prune, reclaiming 64424513536 bytes.",
+			},
+			unwanted: []string{"GiB", "KiB"},
+		},
+		"apply delete": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "cleanup_list.json",
+				},
+				fmt.Sprintf(deletePathTemplate, "analysis.1"): {
+					statusCode:   http.StatusOK,
+					responseFile: "delete_success.json",
+				},
+				fmt.Sprintf(deletePathTemplate, "test-run.1"): {
+					statusCode:   http.StatusOK,
+					responseFile: "delete_success.json",
+				},
+			},
+			args:     []string{"--policy", deletePolicyFile, "--apply", "--yes"},
+			expected: []string{"RESULT", "deleted"},
+			unwanted: []string{"This is a dry run"},
+		},
+		"apply prune": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "cleanup_list.json",
+				},
+				fmt.Sprintf(prunePathTemplate, "analysis.1"): {
+					statusCode:   http.StatusOK,
+					responseFile: "prune_success.json",
+				},
+				fmt.Sprintf(prunePathTemplate, "analysis.2"): {
+					statusCode:   http.StatusOK,
+					responseFile: "prune_success.json",
+				},
+			},
+			args: []string{"-p", prunePolicyFile, "--apply", "-y"},
+			expected: []string{
+				"0 workflow(s) to delete and 2 to prune, reclaiming up to 60 GiB.",
+				"pruned",
+			},
+		},
+		"nothing to clean up": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:     []string{"--policy", prunePolicyFile},
+			expected: []string{"Nothing to clean up according to the policy."},
+		},
+		"invalid policy": {
+			args:      []string{"--policy", invalidPolicyFile},
+			expected:  []string{"invalid value for 'rule-1 action'"},
+			wantError: true,
+		},
+		"missing policy": {
+			args:      []string{"--policy", tempDir + "/missing.yaml"},
+			expected:  []string{"invalid value for '--policy'"},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "cleanup"
+			testCmdRun(t, params)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/displayer"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const collaboratorsDesc = `Summarize the users you share workflows with.
+
+The ` + "`collaborators`" + ` command lists every user you share workflows with,
+together with the number of your workflows each of them can see. Use
+` + "`--verbose`" + ` to also display the names of the workflows.
+
+Examples:
+
+  $ reana-client collaborators
+
+  $ reana-client collaborators --verbose
+`
+
+type collaboratorsOptions struct {
+	token      string
+	verbose    bool
+	jsonOutput bool
+}
+
+// collaborator a user the current user shares workflows with.
+type collaborator struct {
+	Email     string   `json:"email"`
+	Count     int      `json:"workflows"`
+	Workflows []string `json:"workflow_names"`
+}
+
+// newCollaboratorsCmd creates a command to summarize the users you share workflows with.
+func newCollaboratorsCmd() *cobra.Command {
+	o := &collaboratorsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "collaborators",
+		Short: "Summarize the users you share workflows with.",
+		Long:  collaboratorsDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(
+		&o.token,
+		"access-token",
+		"t",
+		"",
+		"Access token of the current user.",
+	)
+	f.BoolVarP(&o.verbose, "verbose", "v", false, "Display the names of the shared workflows.")
+	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
+
+	return cmd
+}
+
+func (o *collaboratorsOptions) run(cmd *cobra.Command) error {
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	if err := requireServerFeature(api, o.token, "sharing", "collaborators"); err != nil {
+		return err
+	}
+
+	youSharedWithParams := operations.NewGetUsersYouSharedWithParams()
+	youSharedWithParams.SetAccessToken(&o.token)
+	youSharedWithResp, err := api.Operations.GetUsersYouSharedWith(youSharedWithParams)
+	if err != nil {
+		return err
+	}
+
+	sharedWith := "anybody"
+	listParams := operations.NewGetWorkflowsParams()
+	listParams.SetAccessToken(&o.token)
+	listParams.SetType("batch")
+	listParams.SetSharedWith(&sharedWith)
+	listParams.SetStatus(config.GetRunStatuses(false))
+	listResp, err := api.Operations.GetWorkflows(listParams)
+	if err != nil {
+		return err
+	}
+
+	var emails []string
+	for _, user := range youSharedWithResp.Payload.Users {
+		emails = append(emails, user.Email)
+	}
+	collaborators := buildCollaborators(emails, listResp.Payload.Items)
+
+	out := cmd.OutOrStdout()
+	if o.jsonOutput {
+		return displayer.DisplayJsonOutput(collaborators, out)
+	}
+	if len(collaborators) == 0 {
+		displayer.DisplayMessage(
+			"You do not share workflows with anyone.",
+			displayer.Info,
+			false,
+			out,
+		)
+		return nil
+	}
+
+	header := []string{"email", "workflows"}
+	if o.verbose {
+		header = append(header, "workflow_names")
+	}
+	var rows [][]string
+	for _, c := range collaborators {
+		row := []string{c.Email, strconv.Itoa(c.Count)}
+		if o.verbose {
+			row = append(row, strings.Join(c.Workflows, "\n"))
+		}
+		rows = append(rows, row)
+	}
+	displayer.DisplayTable(header, rows, out)
+	return nil
+}
+
+// buildCollaborators counts, for each of the users, the given workflows shared with them.
+// Users only found in the workflows are also included. Collaborators are sorted by
+// decreasing number of workflows, then by email.
+func buildCollaborators(
+	emails []string,
+	items []*operations.GetWorkflowsOKBodyItemsItems0,
+) []collaborator {
+	byEmail := make(map[string]*collaborator)
+	get := func(email string) *collaborator {
+		c, exists := byEmail[email]
+		if !exists {
+			c = &collaborator{Email: email, Workflows: []string{}}
+			byEmail[email] = c
+		}
+		return c
+	}
+
+	for _, email := range emails {
+		get(email)
+	}
+	for _, workflow := range items {
+		for _, email := range workflow.SharedWith {
+			c := get(email)
+			c.Count++
+			c.Workflows = append(c.Workflows, workflow.Name)
+		}
+	}
+
+	collaborators := make([]collaborator, 0, len(byEmail))
+	for _, c := range byEmail {
+		sort.Strings(c.Workflows)
+		collaborators = append(collaborators, *c)
+	}
+	sort.Slice(collaborators, func(i, j int) bool {
+		if collaborators[i].Count != collaborators[j].Count {
+			return collaborators[i].Count > collaborators[j].Count
+		}
+		return collaborators[i].Email < collaborators[j].Email
+	})
+	return collaborators
+}
@@ -0,0 +1,85 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestCollaborators(t *testing.T) {
+	serverResponses := map[string]ServerResponse{
+		youSharedWithServerPath: {
+			statusCode:   http.StatusOK,
+			responseFile: "share_list_you_shared_with.json",
+		},
+		listServerPath: {
+			statusCode:   http.StatusOK,
+			responseFile: "collaborators_list.json",
+		},
+	}
+	tests := map[string]TestCmdParams{
+		"default": {
+			serverResponses: serverResponses,
+			expected: []string{
+				"EMAIL", "WORKFLOWS",
+				"bob@cern.ch", "3",
+				"cecile@cern.ch", "1",
+			},
+			unwanted: []string{"WORKFLOW_NAMES", "my_workflow.1"},
+		},
+		"verbose": {
+			serverResponses: serverResponses,
+			args:            []string{"--verbose"},
+			expected: []string{
+				"WORKFLOW_NAMES", "my_workflow.1", "my_workflow.2", "other_workflow.1",
+			},
+		},
+		"json": {
+			serverResponses: serverResponses,
+			args:            []string{"--json"},
+			expected: []string{
+				"\"email\": \"bob@cern.ch\"",
+				"\"workflows\": 3",
+				"\"workflow_names\": [",
+			},
+		},
+		"nobody": {
+			serverResponses: map[string]ServerResponse{
+				youSharedWithServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "common_empty.json",
+				},
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "common_empty.json",
+				},
+			},
+			expected: []string{"You do not share workflows with anyone."},
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "collaborators"
+			testCmdRun(t, params)
+		})
+	}
+}
+
+func TestBuildCollaborators(t *testing.T) {
+	collaborators := buildCollaborators(
+		[]string{"zoe@cern.ch", "bob@cern.ch"},
+		nil,
+	)
+	if len(collaborators) != 2 || collaborators[0].Email != "bob@cern.ch" ||
+		collaborators[0].Count != 0 {
+		t.Errorf("Expected users without workflows sorted by email, got %v", collaborators)
+	}
+}
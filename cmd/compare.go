@@ -0,0 +1,362 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/workflows"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+const compareDesc = `
+Compare how several workflow runs behaved.
+
+The ` + "``compare``" + ` command shows side by side the input parameters, the status,
+the duration, the number of jobs and the workspace size of two or more
+workflows, highlighting the fields whose values differ. It complements
+` + "``diff``" + `, which compares the specifications and the files of two workflows.
+
+Examples:
+
+	$ reana-client compare myanalysis.41 myanalysis.42
+
+	$ reana-client compare myanalysis.40 myanalysis.41 myanalysis.42 --only-differences
+
+	$ reana-client compare myanalysis.41 myanalysis.42 --json
+`
+
+// parameterFieldPrefix prefix of the compared fields holding the input parameters.
+const parameterFieldPrefix = "parameters."
+
+// Compared fields which are not input parameters, in the order they are displayed.
+const (
+	compareStatusField        = "status"
+	compareDurationField      = "duration"
+	compareJobsTotalField     = "jobs_total"
+	compareJobsFinishedField  = "jobs_finished"
+	compareJobsFailedField    = "jobs_failed"
+	compareJobsRunningField   = "jobs_running"
+	compareWorkspaceSizeField = "workspace_size"
+)
+
+type compareOptions struct {
+	token           string
+	workflows       []string
+	jsonOutput      bool
+	onlyDifferences bool
+	sizeOptions
+}
+
+// compareRun is the information about a workflow run compared by the compare command.
+type compareRun struct {
+	status        *operations.GetWorkflowStatusOKBody
+	parameters    map[string]any
+	workspaceSize *int64
+}
+
+// compareField is a field compared between the workflows, with its value for each of them.
+type compareField struct {
+	Name    string `json:"field"`
+	Values  []any  `json:"values"`
+	Differs bool   `json:"differs"`
+}
+
+// newCompareCmd creates a command to compare how several workflow runs behaved.
+func newCompareCmd() *cobra.Command {
+	o := &compareOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "compare WORKFLOW WORKFLOW [WORKFLOW...]",
+		Short: "Compare how several workflow runs behaved.",
+		Long:  compareDesc,
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.workflows = args
+			if err := o.validateSizeFlags(); err != nil {
+				return err
+			}
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(
+		&o.token,
+		"access-token",
+		"t",
+		"",
+		"Access token of the current user.",
+	)
+	f.BoolVar(
+		&o.onlyDifferences,
+		"only-differences",
+		false,
+		"Show only the fields whose values differ.",
+	)
+	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
+	addSizeFlags(
+		f,
+		&o.sizeOptions,
+		"Show workspace sizes in human readable format (default).",
+		true,
+	)
+	// Remove -h shorthand
+	cmd.PersistentFlags().BoolP("help", "", false, "Help for compare")
+
+	cmd.ValidArgsFunction = completeWorkflows
+
+	return cmd
+}
+
+func (o *compareOptions) run(cmd *cobra.Command) error {
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	runs := make([]compareRun, 0, len(o.workflows))
+	for _, workflow := range o.workflows {
+		run, err := getCompareRun(api, o.token, workflow)
+		if err != nil {
+			return err
+		}
+		runs = append(runs, run)
+	}
+	fields, err := buildCompareFields(runs)
+	if err != nil {
+		return err
+	}
+	if o.onlyDifferences {
+		differing := []compareField{}
+		for _, field := range fields {
+			if field.Differs {
+				differing = append(differing, field)
+			}
+		}
+		fields = differing
+	}
+
+	if o.jsonOutput {
+		output := struct {
+			Workflows []string       `json:"workflows"`
+			Fields    []compareField `json:"fields"`
+		}{o.workflows, fields}
+		return displayer.DisplayJsonOutput(output, cmd.OutOrStdout())
+	}
+	displayCompareFields(cmd, o.workflows, fields, o.sizeOptions)
+	return nil
+}
+
+// getCompareRun retrieves the status, the input parameters and the workspace size of a workflow.
+func getCompareRun(api *client.API, token, workflow string) (compareRun, error) {
+	statusParams := operations.NewGetWorkflowStatusParams()
+	statusParams.SetAccessToken(&token)
+	statusParams.SetWorkflowIDOrName(workflow)
+	statusResp, err := api.Operations.GetWorkflowStatus(statusParams)
+	if err != nil {
+		return compareRun{}, err
+	}
+
+	parametersParams := operations.NewGetWorkflowParametersParams()
+	parametersParams.SetAccessToken(&token)
+	parametersParams.SetWorkflowIDOrName(workflow)
+	parametersResp, err := api.Operations.GetWorkflowParameters(parametersParams)
+	if err != nil {
+		return compareRun{}, err
+	}
+
+	duParams := operations.NewGetWorkflowDiskUsageParams()
+	duParams.SetAccessToken(&token)
+	duParams.SetWorkflowIDOrName(workflow)
+	duParams.SetParameters(operations.GetWorkflowDiskUsageBody{Summarize: true})
+	duResp, err := api.Operations.GetWorkflowDiskUsage(duParams)
+	if err != nil {
+		return compareRun{}, err
+	}
+
+	run := compareRun{
+		status:     statusResp.Payload,
+		parameters: parametersResp.Payload.Parameters,
+	}
+	// The workspace size is unknown if the workspace was deleted
+	for _, diskUsageInfo := range duResp.Payload.DiskUsageInfo {
+		if diskUsageInfo.Size != nil {
+			size := diskUsageInfo.Size.Raw
+			run.workspaceSize = &size
+		}
+	}
+	return run, nil
+}
+
+// buildCompareFields builds the compared fields from the information about the runs,
+// marking the ones whose values are not the same for all of them.
+func buildCompareFields(runs []compareRun) ([]compareField, error) {
+	fieldNames := []string{
+		compareStatusField,
+		compareDurationField,
+		compareJobsTotalField,
+		compareJobsFinishedField,
+		compareJobsFailedField,
+		compareJobsRunningField,
+		compareWorkspaceSizeField,
+	}
+	var parameterNames []string
+	for _, run := range runs {
+		for name := range run.parameters {
+			field := parameterFieldPrefix + name
+			if !slices.Contains(parameterNames, field) {
+				parameterNames = append(parameterNames, field)
+			}
+		}
+	}
+	sort.Strings(parameterNames)
+	fieldNames = append(fieldNames, parameterNames...)
+
+	fields := make([]compareField, 0, len(fieldNames))
+	for _, name := range fieldNames {
+		field := compareField{Name: name, Values: make([]any, len(runs))}
+		for i, run := range runs {
+			value, err := run.value(name)
+			if err != nil {
+				return nil, err
+			}
+			field.Values[i] = value
+		}
+		differs, err := valuesDiffer(field.Values)
+		if err != nil {
+			return nil, err
+		}
+		field.Differs = differs
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// value returns the value of a compared field for the run, or nil if it is unknown.
+func (r compareRun) value(field string) (any, error) {
+	progress := r.status.Progress
+	if progress == nil {
+		progress = &operations.GetWorkflowStatusOKBodyProgress{}
+	}
+	switch field {
+	case compareStatusField:
+		return r.status.Status, nil
+	case compareDurationField:
+		return workflows.GetDuration(
+			progress.RunStartedAt,
+			progress.RunFinishedAt,
+			progress.RunStoppedAt,
+		)
+	case compareJobsTotalField:
+		if progress.Total != nil {
+			return progress.Total.Total, nil
+		}
+	case compareJobsFinishedField:
+		if progress.Finished != nil {
+			return progress.Finished.Total, nil
+		}
+	case compareJobsFailedField:
+		if progress.Failed != nil {
+			return progress.Failed.Total, nil
+		}
+	case compareJobsRunningField:
+		if progress.Running != nil {
+			return progress.Running.Total, nil
+		}
+	case compareWorkspaceSizeField:
+		if r.workspaceSize != nil {
+			return *r.workspaceSize, nil
+		}
+	default:
+		if value, ok := r.parameters[field[len(parameterFieldPrefix):]]; ok {
+			return value, nil
+		}
+	}
+	return nil, nil
+}
+
+// valuesDiffer returns whether the values are not all the same.
+func valuesDiffer(values []any) (bool, error) {
+	var first []byte
+	for i, value := range values {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return false, err
+		}
+		if i == 0 {
+			first = encoded
+		} else if string(encoded) != string(first) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// displayCompareFields displays the compared fields in a table with a column per workflow,
+// highlighting the fields whose values differ.
+func displayCompareFields(
+	cmd *cobra.Command,
+	workflowNames []string,
+	fields []compareField,
+	sizes sizeOptions,
+) {
+	header := append([]string{"field"}, workflowNames...)
+	highlight := text.Colors{text.Bold, text.FgYellow}
+	rows := make([][]string, 0, len(fields))
+	for _, field := range fields {
+		row := make([]string, 0, len(header))
+		if field.Differs {
+			row = append(row, highlight.Sprint("* "+field.Name))
+		} else {
+			row = append(row, "  "+field.Name)
+		}
+		for _, value := range field.Values {
+			formatted := formatCompareValue(field.Name, value, sizes)
+			if field.Differs {
+				formatted = highlight.Sprint(formatted)
+			}
+			row = append(row, formatted)
+		}
+		rows = append(rows, row)
+	}
+	displayer.DisplayTable(header, rows, cmd.OutOrStdout())
+}
+
+// formatCompareValue formats the value of a compared field to be displayed.
+func formatCompareValue(field string, value any, sizes sizeOptions) string {
+	switch v := value.(type) {
+	case nil:
+		return "-"
+	case string:
+		return v
+	case int64:
+		if field == compareWorkspaceSizeField {
+			return sizes.formatSize(v)
+		}
+		return strconv.FormatInt(v, 10)
+	case float64:
+		if field == compareDurationField {
+			return formatTimelineDuration(time.Duration(v * float64(time.Second)))
+		}
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "-"
+	}
+	return string(encoded)
+}
@@ -0,0 +1,104 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	workflowA := "my_workflow.10"
+	workflowB := "my_workflow.11"
+	serverResponses := map[string]ServerResponse{
+		fmt.Sprintf(statusPathTemplate, workflowA): {
+			statusCode:   http.StatusOK,
+			responseFile: "status_finished.json",
+		},
+		fmt.Sprintf(paramsPathTemplate, workflowA): {
+			statusCode:   http.StatusOK,
+			responseFile: "start_params_multiple.json",
+		},
+		fmt.Sprintf(duPathTemplate, workflowA): {
+			statusCode:   http.StatusOK,
+			responseFile: "du_summarize.json",
+		},
+		fmt.Sprintf(statusPathTemplate, workflowB): {
+			statusCode:   http.StatusOK,
+			responseFile: "compare_status_failed.json",
+		},
+		fmt.Sprintf(paramsPathTemplate, workflowB): {
+			statusCode:   http.StatusOK,
+			responseFile: "compare_params.json",
+		},
+		fmt.Sprintf(duPathTemplate, workflowB): {
+			statusCode:   http.StatusOK,
+			responseFile: "compare_du.json",
+		},
+	}
+
+	tests := map[string]TestCmdParams{
+		"compare two workflows": {
+			serverResponses: serverResponses,
+			args:            []string{workflowA, workflowB},
+			expected: []string{
+				"FIELD", "MY_WORKFLOW.10", "MY_WORKFLOW.11",
+				"* status", "finished", "failed",
+				"* duration", "15s", "1m0s",
+				"  jobs_total", "* jobs_finished", "* jobs_failed", "* jobs_running",
+				"* workspace_size", "10 KiB", "20 KiB",
+				"  parameters.data", "results/data.root",
+				"* parameters.events", "20", "50",
+				"* parameters.plot", "results/plot.png",
+				"* parameters.seed", "42",
+			},
+		},
+		"only differences": {
+			serverResponses: serverResponses,
+			args:            []string{workflowA, workflowB, "--only-differences", "--bytes"},
+			expected:        []string{"* status", "* workspace_size", "10240", "20480"},
+			unwanted:        []string{"jobs_total", "parameters.data", "KiB"},
+		},
+		"json output": {
+			serverResponses: serverResponses,
+			args:            []string{workflowA, workflowB, "--json"},
+			expected: []string{
+				`"workflows": [`,
+				`"field": "duration"`, `15,`, `60`,
+				`"field": "workspace_size"`, `10240,`, `20480`,
+				`"field": "parameters.plot"`, `"results/plot.png",`, `null`,
+				`"differs": false`, `"differs": true`,
+			},
+		},
+		"unexisting workflow": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(statusPathTemplate, "invalid"): {
+					statusCode:   http.StatusNotFound,
+					responseFile: "common_invalid_workflow.json",
+				},
+			},
+			args:      []string{"invalid", workflowB},
+			expected:  []string{"REANA_WORKON is set to invalid, but that workflow does not exist."},
+			wantError: true,
+		},
+		"single workflow": {
+			args:      []string{workflowA},
+			expected:  []string{"requires at least 2 arg(s), only received 1"},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "compare"
+			testCmdRun(t, params)
+		})
+	}
+}
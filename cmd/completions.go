@@ -0,0 +1,299 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/cache"
+	"reanahub/reana-client-go/pkg/config"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// completionFunc is a function completing the arguments or the values of a flag of a command.
+type completionFunc func(
+	cmd *cobra.Command,
+	args []string,
+	toComplete string,
+) ([]string, cobra.ShellCompDirective)
+
+// Columns of the tables displayed by the commands, completed for their --filter, --format and --sort flags.
+var (
+	lsColumns   = []string{"name", "size", "last-modified"}
+	listColumns = []string{
+		"name", "run_number", "created", "started", "ended", "status",
+		"session_type", "session_uri", "session_status", "id", "user",
+		"size", "progress", "duration", "shared_with", "shared_by",
+	}
+	statusColumns = []string{
+		"name", "run_number", "created", "started", "ended", "status",
+		"progress", "id", "user", "command", "duration",
+	}
+	retentionRulesColumns = []string{"workspace_files", "retention_days", "apply_on", "status"}
+	shareStatusColumns    = []string{"user_email", "valid_until"}
+)
+
+// registerWorkflowCompletion registers the completion of workflow names for the --workflow flag
+// of the given command and of all its subcommands.
+func registerWorkflowCompletion(cmd *cobra.Command) {
+	if cmd.Flags().Lookup("workflow") != nil {
+		registerFlagCompletion(cmd, "workflow", completeWorkflows)
+	}
+	for _, child := range cmd.Commands() {
+		registerWorkflowCompletion(child)
+	}
+}
+
+// registerFlagCompletion registers the completion function of the given flag, logging any failure.
+func registerFlagCompletion(cmd *cobra.Command, flag string, completion completionFunc) {
+	if err := cmd.RegisterFlagCompletionFunc(flag, completion); err != nil {
+		log.Debugf("Failed to register completion of flag %s: %s", flag, err.Error())
+	}
+}
+
+// completeWorkflows completes the names of the workflows of the user, e.g. myanalysis.42.
+func completeWorkflows(
+	cmd *cobra.Command,
+	args []string,
+	toComplete string,
+) ([]string, cobra.ShellCompDirective) {
+	names := cachedCompletions(cmd, "workflows", func(api *client.API, token string) ([]string, error) {
+		listParams := operations.NewGetWorkflowsParams()
+		listParams.SetAccessToken(&token)
+		listParams.SetType("batch")
+		listParams.SetStatus(config.GetRunStatuses(false))
+		listResp, err := api.Operations.GetWorkflows(listParams)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, workflow := range listResp.Payload.Items {
+			names = append(names, workflow.Name)
+		}
+		return names, nil
+	})
+	return filterCompletions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeWorkspacePaths completes the paths of the files in the workspace of the workflow given
+// by --workflow or REANA_WORKON, one directory at a time.
+func completeWorkspacePaths(
+	cmd *cobra.Command,
+	args []string,
+	toComplete string,
+) ([]string, cobra.ShellCompDirective) {
+	workflow := completionFlagValue(cmd, "workflow")
+	if workflow == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	paths := cachedCompletions(cmd, "files:"+workflow, func(api *client.API, token string) ([]string, error) {
+		files, err := listWorkspaceFiles(api, token, workflow, "", "")
+		if err != nil {
+			return nil, err
+		}
+		var paths []string
+		for _, file := range files {
+			paths = append(paths, file.Name)
+		}
+		return paths, nil
+	})
+
+	completions := nextPathComponents(paths, toComplete)
+	directive := cobra.ShellCompDirectiveNoFileComp
+	if len(completions) == 1 && strings.HasSuffix(completions[0], "/") {
+		directive |= cobra.ShellCompDirectiveNoSpace
+	}
+	return completions, directive
+}
+
+// completeSecrets completes the names of the secrets of the user.
+func completeSecrets(
+	cmd *cobra.Command,
+	args []string,
+	toComplete string,
+) ([]string, cobra.ShellCompDirective) {
+	names := cachedCompletions(cmd, "secrets", func(api *client.API, token string) ([]string, error) {
+		secretsParams := operations.NewGetSecretsParams()
+		secretsParams.SetAccessToken(&token)
+		secretsResp, err := api.Operations.GetSecrets(secretsParams)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, secret := range secretsResp.Payload {
+			names = append(names, secret.Name)
+		}
+		return names, nil
+	})
+	return filterCompletions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeFilters returns a function completing --filter flags, given as comma-separated
+// key=value pairs. Keys are completed first, then the values listed for the key, if any.
+func completeFilters(keys []string, values map[string][]string) completionFunc {
+	return func(
+		cmd *cobra.Command,
+		args []string,
+		toComplete string,
+	) ([]string, cobra.ShellCompDirective) {
+		previous, current := splitLastItem(toComplete)
+		if key, _, found := strings.Cut(current, "="); found {
+			var candidates []string
+			for _, value := range values[key] {
+				candidates = append(candidates, previous+key+"="+value)
+			}
+			return filterCompletions(candidates, toComplete), cobra.ShellCompDirectiveNoFileComp
+		}
+
+		var candidates []string
+		for _, key := range keys {
+			candidates = append(candidates, previous+key+"=")
+		}
+		return filterCompletions(candidates, toComplete),
+			cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	}
+}
+
+// completeColumns returns a function completing --format flags, given as comma-separated column names.
+func completeColumns(columns []string) completionFunc {
+	return func(
+		cmd *cobra.Command,
+		args []string,
+		toComplete string,
+	) ([]string, cobra.ShellCompDirective) {
+		previous, _ := splitLastItem(toComplete)
+		var candidates []string
+		for _, column := range columns {
+			candidates = append(candidates, previous+column)
+		}
+		return filterCompletions(candidates, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeSortColumns returns a completion function for the --sort flag, suggesting the given columns
+// in ascending and descending order.
+func completeSortColumns(columns []string) completionFunc {
+	var candidates []string
+	for _, column := range columns {
+		candidates = append(candidates, column, "-"+column)
+	}
+	return completeColumns(candidates)
+}
+
+// cachedCompletions returns the completions of the given kind, from the cache if they were
+// retrieved recently, or by calling fetch otherwise. Errors are only logged, as completion
+// must not fail, e.g. when the access token or the server URL are not set.
+func cachedCompletions(
+	cmd *cobra.Command,
+	kind string,
+	fetch func(api *client.API, token string) ([]string, error),
+) []string {
+	if err := setupViper(); err != nil {
+		log.Debugf("Could not set up the configuration for completion: %v", err)
+		return nil
+	}
+	token := completionFlagValue(cmd, "access-token")
+	if token == "" {
+		return nil
+	}
+
+	key := "completion:" + viper.GetString("server-url") + ":" + token + ":" + kind
+	var completions []string
+	if cache.Load(key, config.CompletionCacheTTL, &completions) {
+		return completions
+	}
+
+	api, err := client.ApiClient()
+	if err != nil {
+		log.Debugf("Could not create the client for completion: %v", err)
+		return nil
+	}
+	completions, err = fetch(api, token)
+	if err != nil {
+		log.Debugf("Could not retrieve the %s for completion: %v", kind, err)
+		return nil
+	}
+	if err := cache.Store(key, completions); err != nil {
+		log.Debugf("Could not cache the %s for completion: %v", kind, err)
+	}
+	return completions
+}
+
+// completionFlagValue returns the value of the given flag of the command,
+// or the value of its environment variable if the flag is not set.
+func completionFlagValue(cmd *cobra.Command, name string) string {
+	if flag := cmd.Flags().Lookup(name); flag != nil && flag.Changed {
+		return flag.Value.String()
+	}
+	if err := setupViper(); err != nil {
+		return ""
+	}
+	return viper.GetString(name)
+}
+
+// nextPathComponents returns the paths starting with toComplete, up to the end of their next
+// directory, so that the workspace is completed one directory at a time.
+// Directories end with a slash.
+func nextPathComponents(paths []string, toComplete string) []string {
+	seen := make(map[string]bool)
+	var completions []string
+	for _, path := range paths {
+		if !strings.HasPrefix(path, toComplete) {
+			continue
+		}
+		completion := path
+		if i := strings.Index(path[len(toComplete):], "/"); i >= 0 {
+			completion = path[:len(toComplete)+i+1]
+		}
+		if !seen[completion] {
+			seen[completion] = true
+			completions = append(completions, completion)
+		}
+	}
+	sort.Strings(completions)
+	return completions
+}
+
+// filterCompletions returns the candidates starting with toComplete.
+func filterCompletions(candidates []string, toComplete string) []string {
+	var completions []string
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, toComplete) {
+			completions = append(completions, candidate)
+		}
+	}
+	return completions
+}
+
+// splitLastItem splits a comma-separated list into the items before the last one,
+// including the last comma, and the last item.
+func splitLastItem(list string) (string, string) {
+	i := strings.LastIndex(list, ",")
+	return list[:i+1], list[i+1:]
+}
+
+// completeUpToNArgs returns a function completing arguments with the given function,
+// as long as fewer than n arguments were given.
+func completeUpToNArgs(n int, completion completionFunc) completionFunc {
+	return func(
+		cmd *cobra.Command,
+		args []string,
+		toComplete string,
+	) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= n {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return completion(cmd, args, toComplete)
+	}
+}
@@ -0,0 +1,213 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reanahub/reana-client-go/client"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func TestCompletions(t *testing.T) {
+	workspacePath := fmt.Sprintf(lsPathTemplate, "my_workflow")
+	tests := map[string]TestCmdParams{
+		"workflow names": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:     []string{"status", "-w", "my_"},
+			expected: []string{"my_workflow.23", "my_workflow2.12", ":4"},
+		},
+		"workspace paths": {
+			serverResponses: map[string]ServerResponse{
+				workspacePath: {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+			},
+			args:     []string{"ls", "-w", "my_workflow", ""},
+			expected: []string{"code/", "results/", ":4"},
+			unwanted: []string{"code/gendata.C"},
+		},
+		"workspace files of a directory": {
+			serverResponses: map[string]ServerResponse{
+				workspacePath: {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+			},
+			args:     []string{"download", "-w", "my_workflow", "results/"},
+			expected: []string{"results/data.root", ":4"},
+		},
+		"no workspace paths after the last argument": {
+			args:     []string{"mv", "-w", "my_workflow", "a", "b", ""},
+			expected: []string{":4"},
+			unwanted: []string{"code/"},
+		},
+		"secret names": {
+			serverResponses: map[string]ServerResponse{
+				secretsListServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "secrets_list.json",
+				},
+			},
+			args:     []string{"secrets-delete", ""},
+			expected: []string{"secret1", "secret2", ":4"},
+		},
+		"server error": {
+			serverResponses: map[string]ServerResponse{
+				secretsListServerPath: {
+					statusCode:   http.StatusInternalServerError,
+					responseFile: "common_internal_server_error.json",
+				},
+			},
+			args:     []string{"secrets-delete", ""},
+			expected: []string{":4"},
+			unwanted: []string{"secret1"},
+		},
+		"filter values": {
+			args:     []string{"list", "--filter", "name=test,status=fin"},
+			expected: []string{"name=test,status=finished", ":4"},
+		},
+		"format columns": {
+			args:     []string{"ls", "--format", "name,si"},
+			expected: []string{"name,size", ":4"},
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "__complete"
+			testCmdRun(t, params)
+		})
+	}
+}
+
+func TestCachedCompletions(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("REANA_ACCESS_TOKEN", "1234")
+	viper.Set("server-url", "https://localhost")
+	t.Cleanup(viper.Reset)
+
+	calls := 0
+	fetch := func(api *client.API, token string) ([]string, error) {
+		calls++
+		return []string{"secret1", "secret2"}, nil
+	}
+	cmd := &cobra.Command{}
+	for i := 0; i < 2; i++ {
+		got := cachedCompletions(cmd, "secrets", fetch)
+		if !reflect.DeepEqual(got, []string{"secret1", "secret2"}) {
+			t.Errorf("expected completions [secret1 secret2], got %v", got)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected the completions to be retrieved once, got %d times", calls)
+	}
+
+	if got := cachedCompletions(cmd, "workflows", func(*client.API, string) ([]string, error) {
+		return nil, errors.New("server error")
+	}); got != nil {
+		t.Errorf("expected no completions on error, got %v", got)
+	}
+}
+
+func TestNextPathComponents(t *testing.T) {
+	paths := []string{
+		"code/gendata.C",
+		"code/fitdata.C",
+		"results/plots/plot.png",
+		"results/data.root",
+		"reana.yaml",
+	}
+	tests := map[string]struct {
+		toComplete string
+		expected   []string
+	}{
+		"workspace root": {
+			toComplete: "",
+			expected:   []string{"code/", "reana.yaml", "results/"},
+		},
+		"prefix": {
+			toComplete: "re",
+			expected:   []string{"reana.yaml", "results/"},
+		},
+		"directory": {
+			toComplete: "results/",
+			expected:   []string{"results/data.root", "results/plots/"},
+		},
+		"no match": {
+			toComplete: "data",
+			expected:   nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := nextPathComponents(paths, test.toComplete)
+			if !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestCompleteFilters(t *testing.T) {
+	complete := completeFilters(
+		[]string{"name", "status"},
+		map[string][]string{"status": {"finished", "failed", "running"}},
+	)
+	tests := map[string]struct {
+		toComplete        string
+		expected          []string
+		expectedDirective cobra.ShellCompDirective
+	}{
+		"keys": {
+			toComplete:        "",
+			expected:          []string{"name=", "status="},
+			expectedDirective: cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace,
+		},
+		"values": {
+			toComplete:        "status=f",
+			expected:          []string{"status=finished", "status=failed"},
+			expectedDirective: cobra.ShellCompDirectiveNoFileComp,
+		},
+		"key after comma": {
+			toComplete:        "status=failed,n",
+			expected:          []string{"status=failed,name="},
+			expectedDirective: cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace,
+		},
+		"key without values": {
+			toComplete:        "name=",
+			expected:          nil,
+			expectedDirective: cobra.ShellCompDirectiveNoFileComp,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, directive := complete(nil, nil, test.toComplete)
+			if !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+			if directive != test.expectedDirective {
+				t.Errorf("expected directive %d, got %d", test.expectedDirective, directive)
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/datautils"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/errorhandler"
+	"reanahub/reana-client-go/pkg/glob"
+	"reanahub/reana-client-go/pkg/validator"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const cpDesc = `
+Copy files between workspaces.
+
+The ` + "``cp``" + ` command copies files from the workspace of a workflow to the
+workspace of another one, without storing them locally: each file is streamed
+from the source workspace to the target one through a bounded buffer.
+
+Sources and target are given as WORKFLOW:PATH. A source can be a file, a
+directory, whose files are all copied, or a glob pattern: '*' matches any
+characters except '/', '**' matches any characters including '/', and braces
+list alternatives, e.g. '{a,b}'. When copying a directory, a glob pattern or
+several sources, or when the target path ends with '/', the target is a
+directory into which the files are copied, keeping their paths relative to
+the source. Otherwise, the target is the path of the copied file.
+
+The ` + "``--from-shared``" + ` option copies the files of workflows that the given user
+shared with you, which can be listed with ` + "``shared-with-me``" + `.
+
+Examples:
+
+	$ reana-client cp myanalysis.42:outputs/hist.root myfit.1:inputs/
+
+	$ reana-client cp myanalysis.42:outputs/hist.root myfit.1:inputs/data.root
+
+	$ reana-client cp myanalysis.42:outputs myfit.1:inputs/
+
+	$ reana-client cp 'myanalysis.42:outputs/**/*.root' myfit.1:inputs/
+
+	$ reana-client cp analysis.3:results/data.root myfit.1:inputs/ --from-shared alice@cern.ch
+`
+
+type cpOptions struct {
+	token      string
+	sources    []workspaceLocation
+	target     workspaceLocation
+	fromShared string
+}
+
+// workspaceLocation is a path in the workspace of a workflow, given as WORKFLOW:PATH.
+type workspaceLocation struct {
+	workflow string
+	path     string
+}
+
+// workspaceCopy is a file copied from a workspace to another.
+type workspaceCopy struct {
+	source workspaceLocation
+	target workspaceLocation
+	// sourceID identifies the source workflow, which differs from its name for shared workflows
+	sourceID string
+}
+
+// newCpCmd creates a command to copy files between workspaces.
+func newCpCmd() *cobra.Command {
+	o := &cpOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "cp SOURCE... TARGET",
+		Short: "Copy files between workspaces.",
+		Long:  cpDesc,
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.sources = nil
+			for _, arg := range args[:len(args)-1] {
+				source, err := parseWorkspaceLocation(arg)
+				if err != nil {
+					return err
+				}
+				o.sources = append(o.sources, source)
+			}
+			target, err := parseWorkspaceLocation(args[len(args)-1])
+			if err != nil {
+				return err
+			}
+			o.target = target
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(
+		&o.token,
+		"access-token",
+		"t",
+		"",
+		"Access token of the current user.",
+	)
+	f.StringVar(
+		&o.fromShared,
+		"from-shared",
+		"",
+		`Email of the user who shared the source workflows
+with you, to copy files from workflows you do not own.`,
+	)
+
+	return cmd
+}
+
+func (o *cpOptions) run(cmd *cobra.Command) error {
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	if o.fromShared != "" {
+		if err := requireServerFeature(api, o.token, "sharing", "--from-shared"); err != nil {
+			return err
+		}
+	}
+
+	copies, err := o.planCopies(api)
+	if err != nil {
+		return err
+	}
+	for _, c := range copies {
+		if err := copyWorkspaceFile(api, o.token, c.sourceID, c.source.path, c.target); err != nil {
+			return fmt.Errorf(
+				"%s could not be copied: %s",
+				formatWorkspaceLocation(c.source),
+				errorhandler.HandleApiError(err).Error(),
+			)
+		}
+		displayer.DisplayMessage(
+			fmt.Sprintf(
+				"%s was successfully copied to %s",
+				formatWorkspaceLocation(c.source),
+				formatWorkspaceLocation(c.target),
+			),
+			displayer.Success,
+			false,
+			cmd.OutOrStdout(),
+		)
+	}
+	return nil
+}
+
+// planCopies lists the files selected by the sources and the paths they are copied to.
+func (o *cpOptions) planCopies(api *client.API) ([]workspaceCopy, error) {
+	targetIsDir := len(o.sources) > 1 || o.target.path == "" ||
+		strings.HasSuffix(o.target.path, "/")
+	sharedIDs := map[string]string{}
+
+	var copies []workspaceCopy
+	for _, source := range o.sources {
+		workflowID := source.workflow
+		if o.fromShared != "" {
+			if _, ok := sharedIDs[source.workflow]; !ok {
+				id, err := resolveSharedWorkflow(api, o.token, o.fromShared, source.workflow)
+				if err != nil {
+					return nil, err
+				}
+				sharedIDs[source.workflow] = id
+			}
+			workflowID = sharedIDs[source.workflow]
+		}
+
+		files, err := listWorkspaceFiles(api, o.token, workflowID, "", "")
+		if err != nil {
+			return nil, err
+		}
+		names, base, isDir, err := selectCopiedFiles(files, source.path)
+		if err != nil {
+			return nil, err
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("%s does not exist", formatWorkspaceLocation(source))
+		}
+		for _, name := range names {
+			target := workspaceLocation{workflow: o.target.workflow, path: glob.Clean(o.target.path)}
+			if targetIsDir || isDir {
+				target.path = path.Join(target.path, strings.TrimPrefix(name, base))
+			}
+			// The upload would overwrite the file while it is being downloaded
+			if (workflowID == o.target.workflow || source.workflow == o.target.workflow) &&
+				target.path == name {
+				return nil, fmt.Errorf(
+					"%s cannot be copied onto itself",
+					formatWorkspaceLocation(workspaceLocation{workflow: source.workflow, path: name}),
+				)
+			}
+			copies = append(copies, workspaceCopy{
+				source:   workspaceLocation{workflow: source.workflow, path: name},
+				target:   target,
+				sourceID: workflowID,
+			})
+		}
+	}
+	return copies, nil
+}
+
+// selectCopiedFiles returns the names of the files selected by the source path, which can be
+// a file, a directory or a glob pattern, and the prefix of their names that is not kept when
+// copying them, i.e. their parent directory. isDir is false if the source is a single file.
+func selectCopiedFiles(
+	files []*workspaceFile,
+	sourcePath string,
+) (names []string, base string, isDir bool, err error) {
+	cleaned := glob.Clean(sourcePath)
+	selected := func(name string) bool { return name == cleaned }
+	switch {
+	case glob.HasMeta(cleaned):
+		p, err := glob.Compile(cleaned)
+		if err != nil {
+			return nil, "", false, err
+		}
+		selected = p.Select
+		// Keep the paths relative to the directories preceding the first special character
+		base = cleaned[:strings.IndexAny(cleaned, "*?[{\\")]
+		base = base[:strings.LastIndex(base, "/")+1]
+		isDir = true
+	case cleaned == "":
+		selected = func(string) bool { return true }
+		isDir = true
+	default:
+		for _, file := range files {
+			if strings.HasPrefix(file.Name, cleaned+"/") {
+				selected = func(name string) bool { return strings.HasPrefix(name, cleaned+"/") }
+				isDir = true
+				break
+			}
+		}
+		if parent := path.Dir(cleaned); parent != "." {
+			base = parent + "/"
+		}
+	}
+
+	for _, file := range files {
+		if selected(file.Name) && !datautils.HasAnyPrefix(file.Name, config.FilesBlacklist) {
+			names = append(names, file.Name)
+		}
+	}
+	return names, base, isDir, nil
+}
+
+// copyWorkspaceFile streams a file from a workspace to another: the file is uploaded as it is
+// downloaded, buffering at most config.WorkspaceCopyBufferSize bytes in memory.
+func copyWorkspaceFile(
+	api *client.API,
+	token, sourceWorkflow, sourcePath string,
+	target workspaceLocation,
+) error {
+	reader, writer := io.Pipe()
+	downloaded := make(chan error, 1)
+	go func() {
+		buffered := bufio.NewWriterSize(writer, config.WorkspaceCopyBufferSize)
+		err := downloadWorkspaceFileTo(api, token, sourceWorkflow, sourcePath, buffered)
+		if err == nil {
+			err = buffered.Flush()
+		}
+		// A nil error closes the pipe with io.EOF, ending the upload
+		writer.CloseWithError(err)
+		downloaded <- err
+	}()
+
+	uploadParams := operations.NewUploadFileParams()
+	uploadParams.SetAccessToken(&token)
+	uploadParams.SetWorkflowIDOrName(target.workflow)
+	uploadParams.SetFileName(target.path)
+	_, uploadErr := api.Operations.UploadFile(uploadParams, withRequestBody(reader))
+	// Stop the download if the upload failed before reading the whole file
+	reader.Close()
+
+	downloadErr := <-downloaded
+	if downloadErr != nil && !errors.Is(downloadErr, io.ErrClosedPipe) {
+		return downloadErr
+	}
+	return uploadErr
+}
+
+// parseWorkspaceLocation parses a location given as WORKFLOW:PATH.
+func parseWorkspaceLocation(arg string) (workspaceLocation, error) {
+	workflow, filePath, found := strings.Cut(arg, ":")
+	if !found {
+		return workspaceLocation{}, fmt.Errorf(
+			"invalid location '%s': expected WORKFLOW:PATH", arg,
+		)
+	}
+	if err := validator.ValidateWorkflow(workflow); err != nil {
+		return workspaceLocation{}, fmt.Errorf("invalid location '%s': %w", arg, err)
+	}
+	return workspaceLocation{workflow: workflow, path: filePath}, nil
+}
+
+// formatWorkspaceLocation formats a location as WORKFLOW:PATH.
+func formatWorkspaceLocation(location workspaceLocation) string {
+	return location.workflow + ":" + location.path
+}
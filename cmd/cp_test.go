@@ -0,0 +1,223 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reanahub/reana-client-go/client"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestCp(t *testing.T) {
+	workflowA := "my_workflow_a"
+	workflowB := "my_workflow_b"
+	sharedRunID := "5f2e8c1b-7a4d-4e3f-8b2c-1d9e0f6a7b02"
+	serverResponses := func(workflow string, files ...string) map[string]ServerResponse {
+		responses := map[string]ServerResponse{
+			fmt.Sprintf(lsPathTemplate, workflow): {
+				statusCode:   http.StatusOK,
+				responseFile: "diff_files_ls_a.json",
+			},
+			fmt.Sprintf(uploadServerPath, workflowB): {
+				statusCode:   http.StatusOK,
+				responseFile: "upload_success.json",
+			},
+		}
+		for _, file := range files {
+			responses[fmt.Sprintf(downloadServerPath, workflow, file)] = ServerResponse{
+				statusCode:      http.StatusOK,
+				responseFile:    "diff_files_data_a.root",
+				responseHeaders: map[string]string{"Content-Type": "application/octet-stream"},
+			}
+		}
+		return responses
+	}
+
+	tests := map[string]TestCmdParams{
+		"file into directory": {
+			serverResponses: serverResponses(workflowA, "results/data.root"),
+			args:            []string{workflowA + ":results/data.root", workflowB + ":inputs/"},
+			expected: []string{
+				"my_workflow_a:results/data.root was successfully copied to my_workflow_b:inputs/data.root",
+			},
+		},
+		"file to another path": {
+			serverResponses: serverResponses(workflowA, "results/data.root"),
+			args:            []string{workflowA + ":results/data.root", workflowB + ":inputs/hist.root"},
+			expected: []string{
+				"my_workflow_a:results/data.root was successfully copied to my_workflow_b:inputs/hist.root",
+			},
+		},
+		"directory": {
+			serverResponses: serverResponses(workflowA, "results/data.root", "results/plot.png"),
+			args:            []string{workflowA + ":results", workflowB + ":inputs"},
+			expected: []string{
+				"copied to my_workflow_b:inputs/results/data.root",
+				"copied to my_workflow_b:inputs/results/plot.png",
+			},
+			unwanted: []string{"fit.py", "only_a.txt"},
+		},
+		"glob pattern": {
+			serverResponses: serverResponses(workflowA, "code/fit.py", "results/plot.png"),
+			args:            []string{workflowA + ":**/*.{py,png}", workflowB + ":"},
+			expected: []string{
+				"my_workflow_a:code/fit.py was successfully copied to my_workflow_b:code/fit.py",
+				"my_workflow_a:results/plot.png was successfully copied to my_workflow_b:results/plot.png",
+			},
+			unwanted: []string{"data.root"},
+		},
+		"glob pattern in a directory": {
+			serverResponses: serverResponses(workflowA, "results/data.root"),
+			args:            []string{workflowA + ":results/*.root", workflowB + ":inputs/"},
+			expected: []string{
+				"my_workflow_a:results/data.root was successfully copied to my_workflow_b:inputs/data.root",
+			},
+		},
+		"several sources": {
+			serverResponses: serverResponses(workflowA, "only_a.txt", "code/fit.py"),
+			args: []string{
+				workflowA + ":only_a.txt", workflowA + ":code/fit.py", workflowB + ":inputs",
+			},
+			expected: []string{
+				"copied to my_workflow_b:inputs/only_a.txt",
+				"copied to my_workflow_b:inputs/fit.py",
+			},
+		},
+		"from shared workflow": {
+			serverResponses: func() map[string]ServerResponse {
+				responses := serverResponses(sharedRunID, "results/data.root")
+				responses[listServerPath] = ServerResponse{
+					statusCode:   http.StatusOK,
+					responseFile: "shared_with_me_list.json",
+				}
+				return responses
+			}(),
+			args: []string{
+				"analysis.1:results/data.root", workflowB + ":inputs/", "--from-shared", "dan@cern.ch",
+			},
+			expected: []string{
+				"analysis.1:results/data.root was successfully copied to my_workflow_b:inputs/data.root",
+			},
+		},
+		"workflow not shared": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "shared_with_me_list.json",
+				},
+			},
+			args: []string{
+				"other.1:results/data.root", workflowB + ":inputs/", "--from-shared", "dan@cern.ch",
+			},
+			expected:  []string{"workflow other.1 is not shared with you by dan@cern.ch"},
+			wantError: true,
+		},
+		"unexisting file": {
+			serverResponses: serverResponses(workflowA),
+			args:            []string{workflowA + ":missing.txt", workflowB + ":inputs/"},
+			expected:        []string{"my_workflow_a:missing.txt does not exist"},
+			wantError:       true,
+		},
+		"download failure": {
+			serverResponses: func() map[string]ServerResponse {
+				responses := serverResponses(workflowA)
+				responses[fmt.Sprintf(downloadServerPath, workflowA, "only_a.txt")] = ServerResponse{
+					statusCode:   http.StatusNotFound,
+					responseFile: "download_file_not_found.json",
+				}
+				return responses
+			}(),
+			args:      []string{workflowA + ":only_a.txt", workflowB + ":inputs/"},
+			expected:  []string{"my_workflow_a:only_a.txt could not be copied: file does not exist."},
+			wantError: true,
+		},
+		"same file": {
+			serverResponses: serverResponses(workflowA),
+			args:            []string{workflowA + ":results/data.root", workflowA + ":results/"},
+			expected:        []string{"my_workflow_a:results/data.root cannot be copied onto itself"},
+			wantError:       true,
+		},
+		"same directory": {
+			serverResponses: serverResponses(workflowA),
+			args:            []string{workflowA + ":results", workflowA + ":"},
+			expected:        []string{"my_workflow_a:results/data.root cannot be copied onto itself"},
+			wantError:       true,
+		},
+		"invalid location": {
+			args:      []string{"results/data.root", workflowB + ":inputs/"},
+			expected:  []string{"invalid location 'results/data.root': expected WORKFLOW:PATH"},
+			wantError: true,
+		},
+		"missing target": {
+			args:      []string{workflowA + ":results/data.root"},
+			expected:  []string{"requires at least 2 arg(s), only received 1"},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "cp"
+			testCmdRun(t, params)
+		})
+	}
+}
+
+func TestCopyWorkspaceFile(t *testing.T) {
+	// Larger than the buffer, so that the file is streamed in several parts
+	content := bytes.Repeat([]byte("0123456789abcdef"), 200000)
+	var uploaded []byte
+	var uploadedName string
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/api/workflows/source/workspace/data.bin":
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(content)
+			case r.Method == http.MethodPost && r.URL.Path == "/api/workflows/target/workspace":
+				uploadedName = r.URL.Query().Get("file_name")
+				var err error
+				if uploaded, err = io.ReadAll(r.Body); err != nil {
+					t.Errorf("failed to read uploaded file: %s", err.Error())
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"message": "uploaded"}`))
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}),
+	)
+	viper.Set("server-url", server.URL)
+	t.Cleanup(func() {
+		server.Close()
+		viper.Reset()
+	})
+
+	api, err := client.ApiClient()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	target := workspaceLocation{workflow: "target", path: "inputs/data.bin"}
+	if err := copyWorkspaceFile(api, "1234", "source", "data.bin", target); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if uploadedName != target.path {
+		t.Errorf("expected file to be uploaded to %s, got %s", target.path, uploadedName)
+	}
+	if !bytes.Equal(uploaded, content) {
+		t.Errorf("expected %d bytes to be uploaded, got %d", len(content), len(uploaded))
+	}
+}
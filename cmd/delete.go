@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2023, 2025 CERN.
+Copyright (C) 2022, 2023, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -9,6 +9,7 @@ under the terms of the MIT License; see LICENSE file for more details.
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"reanahub/reana-client-go/pkg/displayer"
 	"reanahub/reana-client-go/pkg/workflows"
@@ -24,11 +25,18 @@ the workspace and any open session attached to it will always be deleted,
 even when ` + "``--include-workspace``" + ` is not specified. Note also that you can
 remove all past runs of a workflow by specifying ` + "``--include-all-runs``" + ` flag.
 
+Instead of a single workflow, you can delete all the workflows matching a
+selection by using the ` + "``--filter``" + `, ` + "``--older-than``" + ` and ` + "``--name-prefix``" + `
+flags. The selected workflows are displayed and a confirmation is asked
+before deleting them, unless ` + "``--yes``" + ` is specified.
+
 Example:
 
 $ reana-client delete -w myanalysis.42
 
 $ reana-client delete -w myanalysis.42 --include-all-runs
+
+$ reana-client delete --filter status=failed --older-than 30d --name-prefix test-
 `
 
 type deleteOptions struct {
@@ -36,6 +44,7 @@ type deleteOptions struct {
 	workflow         string
 	includeWorkspace bool
 	includeAllRuns   bool
+	selector         bulkSelector
 }
 
 // newDeleteCmd creates a command to delete a workflow.
@@ -48,6 +57,17 @@ func newDeleteCmd() *cobra.Command {
 		Long:  deleteDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.selector.validate(cmd.Flags()); err != nil {
+				return err
+			}
+			if isBulkSelection(cmd.Flags()) {
+				if o.includeAllRuns {
+					return errors.New(
+						"--include-all-runs cannot be used together with the selection flags",
+					)
+				}
+				return o.runBulk(cmd)
+			}
 			return o.run(cmd)
 		},
 	}
@@ -81,6 +101,7 @@ func newDeleteCmd() *cobra.Command {
 		false,
 		"Delete all runs of a given workflow.",
 	)
+	o.selector.addFlags(f)
 
 	return cmd
 }
@@ -119,3 +140,29 @@ func (o *deleteOptions) run(cmd *cobra.Command) error {
 
 	return nil
 }
+
+// runBulk deletes all the workflows matching the selection flags.
+func (o *deleteOptions) runBulk(cmd *cobra.Command) error {
+	targets, err := o.selector.resolve(o.token, nil)
+	if err != nil {
+		return err
+	}
+	return o.selector.runBulkAction(
+		cmd,
+		targets,
+		"delete",
+		func(workflow string) (string, error) {
+			err := workflows.UpdateStatus(
+				o.token,
+				workflow,
+				"deleted",
+				o.includeWorkspace,
+				false,
+			)
+			if err != nil {
+				return "", err
+			}
+			return "deleted", nil
+		},
+	)
+}
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022 CERN.
+Copyright (C) 2022, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -68,6 +68,101 @@ func TestDelete(t *testing.T) {
 				"All workflows named 'my_workflow' have been deleted",
 			},
 		},
+		"bulk by name prefix": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+				fmt.Sprintf(deletePathTemplate, "my_workflow2.12"): {
+					statusCode:   http.StatusOK,
+					responseFile: "delete_success.json",
+				},
+			},
+			args: []string{"--name-prefix", "my_workflow2", "--yes"},
+			expected: []string{
+				"NAME", "STATUS", "CREATED",
+				"my_workflow2.12", "running", "2022-08-10T17:14:12",
+				"WORKFLOW", "RESULT", "deleted",
+			},
+			unwanted: []string{"my_workflow.23"},
+		},
+		"bulk older than": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+				fmt.Sprintf(deletePathTemplate, "my_workflow.23"): {
+					statusCode:   http.StatusOK,
+					responseFile: "delete_success.json",
+				},
+				fmt.Sprintf(deletePathTemplate, "my_workflow2.12"): {
+					statusCode:   http.StatusOK,
+					responseFile: "delete_success.json",
+				},
+			},
+			args: []string{"--older-than", "30d", "-y"},
+			expected: []string{
+				"my_workflow.23", "my_workflow2.12", "deleted",
+			},
+		},
+		"bulk partial failure": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+				fmt.Sprintf(deletePathTemplate, "my_workflow.23"): {
+					statusCode:   http.StatusOK,
+					responseFile: "delete_success.json",
+				},
+				fmt.Sprintf(deletePathTemplate, "my_workflow2.12"): {
+					statusCode:   http.StatusNotFound,
+					responseFile: "common_invalid_workflow.json",
+				},
+			},
+			args: []string{"--name-prefix", "my_workflow", "--yes"},
+			expected: []string{
+				"failed: REANA_WORKON is set to invalid, but that workflow does not exist.",
+				"Failed to delete 1 out of 2 workflow(s).",
+			},
+			wantError: true,
+		},
+		"bulk no matches": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:     []string{"--name-prefix", "other", "--yes"},
+			expected: []string{"No workflows match the given selection."},
+		},
+		"bulk with workflow": {
+			args: []string{"-w", workflowName, "--name-prefix", "my_workflow"},
+			expected: []string{
+				"please provide either --workflow or one of --filter, --older-than, --name-prefix, not both",
+			},
+			wantError: true,
+		},
+		"bulk with all runs": {
+			args: []string{"--name-prefix", "my_workflow", "--include-all-runs"},
+			expected: []string{
+				"--include-all-runs cannot be used together with the selection flags",
+			},
+			wantError: true,
+		},
+		"bulk invalid older than": {
+			args:      []string{"--older-than", "soon"},
+			expected:  []string{"invalid value for '--older-than'"},
+			wantError: true,
+		},
+		"bulk invalid filter": {
+			args:      []string{"--filter", "disk=10"},
+			expected:  []string{"filter key 'disk' is not valid"},
+			wantError: true,
+		},
 	}
 
 	for name, params := range tests {
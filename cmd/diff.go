@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -10,19 +10,24 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"os"
 	"reanahub/reana-client-go/client"
 	"reanahub/reana-client-go/client/operations"
 	"reanahub/reana-client-go/pkg/config"
 	"reanahub/reana-client-go/pkg/datautils"
 	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/validator"
 
 	"github.com/iancoleman/orderedmap"
 
 	"github.com/jedib0t/go-pretty/v6/text"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 const diffDesc = `
@@ -32,19 +37,41 @@ The ` + "``diff``" + ` command allows to compare two workflows, the workflow_a a
 workflow_b, which must be provided as arguments. The output will show the
 difference in workflow run parameters, the generated files, the logs, etc.
 
+The ` + "``--files``" + ` option downloads the files of both workspaces matching the
+given glob pattern and compares their contents locally. The changed lines of
+text files are shown, whereas binary and ROOT files, as well as text files
+larger than 10 MiB, are summarised by their size and checksum.
+
+The ` + "``--local``" + ` option compares a local directory, such as the one the workflow
+was submitted from, with the workspace of the workflow given by ` + "``--workflow``" + `.
+Files are compared by size, unless ` + "``--files``" + ` is also given, in which case the
+contents of the matching files are compared.
+
 Examples:
 
 	$ reana-client diff myanalysis.42 myotheranalysis.43
 
 	$ reana-client diff myanalysis.42 myotheranalysis.43 --brief
+
+	$ reana-client diff myanalysis.42 myanalysis.43 --files 'results/**/*.{txt,root}'
+
+	$ reana-client diff --local ./myanalysis -w myanalysis.42
+
+	$ reana-client diff --local . -w myanalysis.42 --files 'code/*.py'
+
+	$ reana-client diff myanalysis.42 myotheranalysis.43 --json
 `
 
 type diffOptions struct {
-	token     string
-	workflowA string
-	workflowB string
-	brief     bool
-	unified   int
+	token      string
+	workflowA  string
+	workflowB  string
+	workflow   string
+	brief      bool
+	unified    int
+	files      string
+	localDir   string
+	jsonOutput bool
 }
 
 // newDiffCmd creates a command to show diff between two workflows.
@@ -55,10 +82,40 @@ func newDiffCmd() *cobra.Command {
 		Use:   "diff",
 		Short: "Show diff between two workflows.",
 		Long:  diffDesc,
-		Args:  cobra.ExactArgs(2),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if o.localDir != "" {
+				if len(args) != 0 {
+					return errors.New(
+						"please provide either two workflows or --local, not both",
+					)
+				}
+				return nil
+			}
+			return cobra.ExactArgs(2)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			o.workflowA = args[0]
-			o.workflowB = args[1]
+			if o.localDir == "" {
+				if o.workflow != "" {
+					return errors.New("--workflow can only be used together with --local")
+				}
+				o.workflowA = args[0]
+				o.workflowB = args[1]
+				return o.run(cmd)
+			}
+
+			if o.workflow == "" {
+				o.workflow = viper.GetString("workflow")
+			}
+			if err := validator.ValidateWorkflow(o.workflow); err != nil {
+				return err
+			}
+			info, err := os.Stat(o.localDir)
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() {
+				return fmt.Errorf("%s is not a directory", o.localDir)
+			}
 			return o.run(cmd)
 		},
 	}
@@ -71,6 +128,15 @@ func newDiffCmd() *cobra.Command {
 		"",
 		"Access token of the current user.",
 	)
+	f.StringVarP(
+		&o.workflow,
+		"workflow",
+		"w",
+		"",
+		`Name or UUID of the workflow compared with the
+local directory given by --local. Overrides value
+of REANA_WORKON environment variable.`,
+	)
 	f.BoolVarP(
 		&o.brief,
 		"brief",
@@ -86,126 +152,221 @@ files in the two workspaces are shown.`,
 		5,
 		"Sets number of context lines for workspace diff output.",
 	)
+	f.StringVar(
+		&o.files,
+		"files",
+		"",
+		`Download the files matching the glob pattern
+from both sides and compare their contents locally.`,
+	)
+	f.StringVar(
+		&o.localDir,
+		"local",
+		"",
+		"Compare the given local directory with the workspace of the workflow.",
+	)
+	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
+
+	// The workflow is only needed with --local, so it is validated when running the command
+	err := f.SetAnnotation("workflow", "properties", []string{"optional"})
+	if err != nil {
+		log.Debugf("Failed to set workflow annotation: %s", err.Error())
+	}
 
 	return cmd
 }
 
 func (o *diffOptions) run(cmd *cobra.Command) error {
-	diffParams := operations.NewGetWorkflowDiffParams()
-	diffParams.SetAccessToken(&o.token)
-	diffParams.SetWorkflowIDOrNamea(o.workflowA)
-	diffParams.SetWorkflowIDOrNameb(o.workflowB)
-	diffParams.SetBrief(&o.brief)
-	contextLines := fmt.Sprintf("%d", o.unified)
-	diffParams.SetContextLines(&contextLines)
-
 	api, err := client.ApiClient()
 	if err != nil {
 		return err
 	}
-	diffResp, err := api.Operations.GetWorkflowDiff(diffParams)
-	if err != nil {
-		return err
+
+	var specificationDiff *orderedmap.OrderedMap
+	var workspaceDiff []string
+	var a, b diffSource
+	if o.localDir == "" {
+		diffParams := operations.NewGetWorkflowDiffParams()
+		diffParams.SetAccessToken(&o.token)
+		diffParams.SetWorkflowIDOrNamea(o.workflowA)
+		diffParams.SetWorkflowIDOrNameb(o.workflowB)
+		// The contents of the files are compared locally with --files
+		brief := o.brief || o.files != ""
+		diffParams.SetBrief(&brief)
+		contextLines := fmt.Sprintf("%d", o.unified)
+		diffParams.SetContextLines(&contextLines)
+
+		diffResp, err := api.Operations.GetWorkflowDiff(diffParams)
+		if err != nil {
+			return err
+		}
+		specificationDiff, err = parseSpecificationDiff(diffResp.Payload)
+		if err != nil {
+			return err
+		}
+		if o.files == "" {
+			workspaceDiff, err = parseWorkspaceDiff(diffResp.Payload)
+			if err != nil {
+				return err
+			}
+		}
+		a = workspaceDiffSource{api: api, token: o.token, workflow: o.workflowA}
+		b = workspaceDiffSource{api: api, token: o.token, workflow: o.workflowB}
+	} else {
+		a = localDiffSource{dir: o.localDir}
+		b = workspaceDiffSource{api: api, token: o.token, workflow: o.workflow}
 	}
 
-	err = displayDiffPayload(cmd, diffResp.Payload)
-	if err != nil {
-		return err
+	var filesDiff []fileDiff
+	comparesFiles := o.files != "" || o.localDir != ""
+	if comparesFiles {
+		pattern := o.files
+		if pattern == "" {
+			pattern = allFilesPattern
+		}
+		filesDiff, err = diffFiles(a, b, pattern, o.files != "", o.unified)
+		if err != nil {
+			return err
+		}
+	}
+
+	if o.jsonOutput {
+		output := orderedmap.New()
+		if specificationDiff != nil {
+			output.Set("reana_specification", specificationDiff)
+		}
+		if comparesFiles {
+			output.Set("source_a", a.label())
+			output.Set("source_b", b.label())
+			output.Set("files", filesDiff)
+		} else {
+			output.Set("workspace_listing", workspaceDiff)
+		}
+		return displayer.DisplayJsonOutput(output, cmd.OutOrStdout())
 	}
 
+	displaySpecificationDiff(cmd, specificationDiff)
+	if comparesFiles {
+		displayFilesDiff(cmd, filesDiff, a.label(), b.label())
+	} else {
+		displayWorkspaceDiff(cmd, workspaceDiff)
+	}
 	return nil
 }
 
-func displayDiffPayload(
-	cmd *cobra.Command,
+// parseSpecificationDiff parses the differences of the REANA specifications, by section.
+// Returns nil if the server did not compare the specifications.
+func parseSpecificationDiff(
 	p *operations.GetWorkflowDiffOKBody,
-) error {
-	if p.ReanaSpecification != "" {
-		specificationDiff := orderedmap.New()
-		err := json.Unmarshal([]byte(p.ReanaSpecification), &specificationDiff)
-		if err != nil {
-			return err
-		}
+) (*orderedmap.OrderedMap, error) {
+	if p.ReanaSpecification == "" {
+		return nil, nil
+	}
+	specificationDiff := orderedmap.New()
+	err := json.Unmarshal([]byte(p.ReanaSpecification), &specificationDiff)
+	if err != nil {
+		return nil, err
+	}
 
-		// Rename section workflow to specification
-		val, hasWorkflow := specificationDiff.Get("workflow")
-		if hasWorkflow {
-			specificationDiff.Set("specification", val)
-			specificationDiff.Delete("workflow")
+	// Rename section workflow to specification
+	val, hasWorkflow := specificationDiff.Get("workflow")
+	if hasWorkflow {
+		specificationDiff.Set("specification", val)
+		specificationDiff.Delete("workflow")
+	}
+	for _, section := range specificationDiff.Keys() {
+		// Convert diff to a slice of strings
+		sectionDiffs, _ := specificationDiff.Get(section)
+		linesInterface, ok := sectionDiffs.([]any)
+		if !ok {
+			return nil, fmt.Errorf(
+				"expected diff to be an array, got %v",
+				sectionDiffs,
+			)
 		}
-		equalSpecification := true
-		for _, section := range specificationDiff.Keys() {
-			// Convert diff to a slice of strings
-			sectionDiffs, _ := specificationDiff.Get(section)
-			linesInterface, ok := sectionDiffs.([]any)
+		lines := make([]string, 0, len(linesInterface))
+		for _, line := range linesInterface {
+			lineString, ok := line.(string)
 			if !ok {
-				return fmt.Errorf(
-					"expected diff to be an array, got %v",
-					sectionDiffs,
+				return nil, fmt.Errorf(
+					"expected diff line to be a string, got %v",
+					line,
 				)
 			}
-			lines := make([]string, 0, len(linesInterface))
-			for _, line := range linesInterface {
-				lineString, ok := line.(string)
-				if !ok {
-					return fmt.Errorf(
-						"expected diff line to be a string, got %v",
-						line,
-					)
-				}
-				lines = append(lines, lineString)
-			}
-
-			if len(lines) != 0 {
-				equalSpecification = false
-				displayer.PrintColorable(
-					fmt.Sprintf(
-						"%s Differences in workflow %s\n",
-						config.LeadingMark,
-						section,
-					),
-					cmd.OutOrStdout(),
-					text.FgYellow,
-					text.Bold,
-				)
-				printDiff(lines, cmd.OutOrStdout())
-			}
+			lines = append(lines, lineString)
 		}
-		if equalSpecification {
-			displayer.PrintColorable(
-				fmt.Sprintf(
-					"%s No differences in REANA specifications.\n",
-					config.LeadingMark,
-				),
-				cmd.OutOrStdout(),
-				text.FgYellow,
-				text.Bold,
-			)
-		}
-		cmd.Println() // Separation line
+		specificationDiff.Set(section, lines)
 	}
+	return specificationDiff, nil
+}
 
+// parseWorkspaceDiff parses the differences of the workspace listings.
+func parseWorkspaceDiff(p *operations.GetWorkflowDiffOKBody) ([]string, error) {
 	var workspaceDiffRaw string
 	err := json.Unmarshal([]byte(p.WorkspaceListing), &workspaceDiffRaw)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	workspaceDiff := []string{}
 	if workspaceDiffRaw != "" {
-		workspaceDiff := datautils.SplitLinesNoEmpty(workspaceDiffRaw)
+		workspaceDiff = datautils.SplitLinesNoEmpty(workspaceDiffRaw)
+	}
+	return workspaceDiff, nil
+}
 
-		displayer.PrintColorable(
-			fmt.Sprintf(
-				"%s Differences in workflow workspace\n",
-				config.LeadingMark,
-			),
-			cmd.OutOrStdout(),
-			text.FgYellow,
-			text.Bold,
-		)
-		printDiff(workspaceDiff, cmd.OutOrStdout())
+// displaySpecificationDiff displays the differences of the REANA specifications, if compared.
+func displaySpecificationDiff(cmd *cobra.Command, specificationDiff *orderedmap.OrderedMap) {
+	if specificationDiff == nil {
+		return
+	}
+	equalSpecification := true
+	for _, section := range specificationDiff.Keys() {
+		sectionDiffs, _ := specificationDiff.Get(section)
+		lines := sectionDiffs.([]string)
+		if len(lines) != 0 {
+			equalSpecification = false
+			printDiffHeader(
+				fmt.Sprintf("Differences in workflow %s", section),
+				cmd.OutOrStdout(),
+			)
+			printDiff(lines, cmd.OutOrStdout())
+		}
+	}
+	if equalSpecification {
+		printDiffHeader("No differences in REANA specifications.", cmd.OutOrStdout())
 	}
+	cmd.Println() // Separation line
+}
 
-	return nil
+// displayWorkspaceDiff displays the differences of the workspace listings.
+func displayWorkspaceDiff(cmd *cobra.Command, workspaceDiff []string) {
+	if len(workspaceDiff) == 0 {
+		return
+	}
+	printDiffHeader("Differences in workflow workspace", cmd.OutOrStdout())
+	printDiff(workspaceDiff, cmd.OutOrStdout())
+}
+
+// displayFilesDiff displays the differences of the files compared locally.
+func displayFilesDiff(cmd *cobra.Command, filesDiff []fileDiff, labelA, labelB string) {
+	if !changedFiles(filesDiff) {
+		printDiffHeader("No differences in workflow files.", cmd.OutOrStdout())
+		return
+	}
+	printDiffHeader("Differences in workflow files", cmd.OutOrStdout())
+	for _, d := range filesDiff {
+		printDiff(d.lines(labelA, labelB), cmd.OutOrStdout())
+	}
+}
+
+// printDiffHeader prints the header of a section of differences.
+func printDiffHeader(header string, out io.Writer) {
+	displayer.PrintColorable(
+		fmt.Sprintf("%s %s\n", config.LeadingMark, header),
+		out,
+		text.FgYellow,
+		text.Bold,
+	)
 }
 
 func printDiff(lines []string, out io.Writer) {
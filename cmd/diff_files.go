@@ -0,0 +1,351 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/datautils"
+	"reanahub/reana-client-go/pkg/glob"
+	"reanahub/reana-client-go/pkg/textdiff"
+	"sort"
+)
+
+// Statuses of the files compared by the diff command.
+const (
+	fileOnlyInA   = "only_in_a"
+	fileOnlyInB   = "only_in_b"
+	fileModified  = "modified"
+	fileIdentical = "identical"
+	fileSameSize  = "same_size"
+)
+
+// Types of the files whose contents are compared by the diff command.
+const (
+	fileTypeText   = "text"
+	fileTypeBinary = "binary"
+	fileTypeROOT   = "root"
+)
+
+// allFilesPattern pattern selecting all the files of a workspace or directory.
+const allFilesPattern = "**"
+
+// diffSource is a set of files compared by the diff command, either a workspace or a local directory.
+type diffSource interface {
+	// label returns the name of the source shown in the differences.
+	label() string
+	// files returns the size of the files selected by the pattern, by path.
+	files(pattern *glob.Pattern) (map[string]int64, error)
+	// open returns a reader of the content of a file.
+	open(name string) (io.ReadCloser, error)
+}
+
+// workspaceDiffSource files of a workflow workspace.
+type workspaceDiffSource struct {
+	api      *client.API
+	token    string
+	workflow string
+}
+
+func (s workspaceDiffSource) label() string {
+	return s.workflow
+}
+
+func (s workspaceDiffSource) files(pattern *glob.Pattern) (map[string]int64, error) {
+	files, err := listWorkspaceFiles(s.api, s.token, s.workflow, "", "")
+	if err != nil {
+		return nil, err
+	}
+	sizes := map[string]int64{}
+	for _, file := range files {
+		if pattern.Select(file.Name) && !datautils.HasAnyPrefix(file.Name, config.FilesBlacklist) {
+			sizes[file.Name] = file.Size.Raw
+		}
+	}
+	return sizes, nil
+}
+
+func (s workspaceDiffSource) open(name string) (io.ReadCloser, error) {
+	return openWorkspaceFile(s.api, s.token, s.workflow, name), nil
+}
+
+// localDiffSource files of a local directory.
+type localDiffSource struct {
+	dir string
+}
+
+func (s localDiffSource) label() string {
+	return filepath.ToSlash(filepath.Clean(s.dir))
+}
+
+func (s localDiffSource) files(pattern *glob.Pattern) (map[string]int64, error) {
+	sizes := map[string]int64{}
+	err := filepath.WalkDir(s.dir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(s.dir, filePath)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
+		if d.IsDir() {
+			if name != "." && datautils.HasAnyPrefix(name+"/", config.FilesBlacklist) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() || !pattern.Select(name) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		sizes[name] = info.Size()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sizes, nil
+}
+
+func (s localDiffSource) open(name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(s.dir, filepath.FromSlash(name)))
+}
+
+// fileDiff is the difference between the two versions of a file.
+type fileDiff struct {
+	Path      string   `json:"path"`
+	Status    string   `json:"status"`
+	Type      string   `json:"type,omitempty"`
+	SizeA     *int64   `json:"size_a,omitempty"`
+	SizeB     *int64   `json:"size_b,omitempty"`
+	ChecksumA string   `json:"checksum_a,omitempty"`
+	ChecksumB string   `json:"checksum_b,omitempty"`
+	Diff      []string `json:"diff,omitempty"`
+}
+
+// fileContent is a file read by the diff command. Only text files of at most config.DiffMaxTextSize
+// bytes are held in memory, other files being only summarized by their size and checksum.
+type fileContent struct {
+	size     int64
+	checksum string
+	fileType string
+	// text is the content of the file, or nil if it is not held in memory
+	text []byte
+}
+
+// diffFiles compares the files of the two sources selected by the pattern. If readContents is false,
+// the files are only compared by their size, otherwise their contents are downloaded and compared,
+// showing the changed lines of text files with the given number of context lines.
+func diffFiles(
+	a, b diffSource,
+	pattern string,
+	readContents bool,
+	contextLines int,
+) ([]fileDiff, error) {
+	p, err := glob.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	filesA, err := a.files(p)
+	if err != nil {
+		return nil, err
+	}
+	filesB, err := b.files(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for name := range filesA {
+		paths = append(paths, name)
+	}
+	for name := range filesB {
+		if _, ok := filesA[name]; !ok {
+			paths = append(paths, name)
+		}
+	}
+	sort.Strings(paths)
+
+	diffs := make([]fileDiff, 0, len(paths))
+	for _, name := range paths {
+		d := fileDiff{Path: name}
+		sizeA, inA := filesA[name]
+		sizeB, inB := filesB[name]
+		if inA {
+			d.SizeA = &sizeA
+		}
+		if inB {
+			d.SizeB = &sizeB
+		}
+		switch {
+		case !inB:
+			d.Status = fileOnlyInA
+		case !inA:
+			d.Status = fileOnlyInB
+		case !readContents && sizeA == sizeB:
+			d.Status = fileSameSize
+		case !readContents:
+			d.Status = fileModified
+		default:
+			if err := d.compareContents(a, b, contextLines); err != nil {
+				return nil, err
+			}
+		}
+		diffs = append(diffs, d)
+	}
+	return diffs, nil
+}
+
+// compareContents reads the file from both sources and compares their contents.
+func (d *fileDiff) compareContents(a, b diffSource, contextLines int) error {
+	contentA, err := readFileContent(a, d.Path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s from %s: %w", d.Path, a.label(), err)
+	}
+	contentB, err := readFileContent(b, d.Path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s from %s: %w", d.Path, b.label(), err)
+	}
+	// The size of the read content is more accurate than the one of the listing
+	d.SizeA, d.SizeB = &contentA.size, &contentB.size
+	d.ChecksumA, d.ChecksumB = contentA.checksum, contentB.checksum
+
+	switch {
+	case contentA.fileType == fileTypeROOT || contentB.fileType == fileTypeROOT:
+		d.Type = fileTypeROOT
+	case contentA.fileType == fileTypeBinary || contentB.fileType == fileTypeBinary:
+		d.Type = fileTypeBinary
+	default:
+		d.Type = fileTypeText
+	}
+
+	if contentA.size == contentB.size && contentA.checksum == contentB.checksum {
+		d.Status = fileIdentical
+		return nil
+	}
+	d.Status = fileModified
+	if d.Type == fileTypeText && contentA.text != nil && contentB.text != nil {
+		d.Diff, _ = textdiff.Unified(
+			string(contentA.text),
+			string(contentB.text),
+			path.Join(a.label(), d.Path),
+			path.Join(b.label(), d.Path),
+			contextLines,
+		)
+	}
+	return nil
+}
+
+// readFileContent reads a file of the source as it is streamed, detecting its type from its first
+// bytes and computing its checksum. The content of text files is kept unless it is too large.
+func readFileContent(source diffSource, name string) (fileContent, error) {
+	reader, err := source.open(name)
+	if err != nil {
+		return fileContent{}, err
+	}
+	defer reader.Close()
+
+	head := make([]byte, textdiff.SniffLength)
+	n, err := io.ReadFull(reader, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return fileContent{}, err
+	}
+	head = head[:n]
+	content := fileContent{fileType: fileTypeText}
+	switch {
+	case textdiff.IsROOT(head):
+		content.fileType = fileTypeROOT
+	case textdiff.IsBinary(head):
+		content.fileType = fileTypeBinary
+	}
+
+	hash := sha256.New()
+	hash.Write(head)
+	copied := int64(0)
+	if content.fileType == fileTypeText {
+		// Keep the text in memory until it is larger than the limit, then only hash the rest
+		text := bytes.NewBuffer(head)
+		copied, err = io.CopyN(io.MultiWriter(hash, text), reader, config.DiffMaxTextSize-int64(n)+1)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fileContent{}, err
+		}
+		if int64(text.Len()) <= config.DiffMaxTextSize {
+			content.text = text.Bytes()
+		}
+	}
+	rest, err := io.Copy(hash, reader)
+	if err != nil {
+		return fileContent{}, err
+	}
+	content.size = int64(n) + copied + rest
+	content.checksum = "sha256:" + hex.EncodeToString(hash.Sum(nil))
+	return content, nil
+}
+
+// lines returns the lines describing the difference, or none if the file did not change.
+func (d fileDiff) lines(labelA, labelB string) []string {
+	nameA, nameB := path.Join(labelA, d.Path), path.Join(labelB, d.Path)
+	switch d.Status {
+	case fileOnlyInA:
+		return []string{fmt.Sprintf("Only in %s: %s", labelA, d.Path)}
+	case fileOnlyInB:
+		return []string{fmt.Sprintf("Only in %s: %s", labelB, d.Path)}
+	case fileModified:
+		break
+	default:
+		return nil
+	}
+
+	if len(d.Diff) != 0 {
+		return d.Diff
+	}
+	sizes := fmt.Sprintf("  size: %d -> %d bytes", *d.SizeA, *d.SizeB)
+	if d.Type == "" {
+		// Compared by size only
+		return []string{fmt.Sprintf("Files %s and %s differ", nameA, nameB), sizes}
+	}
+	// Text files that are too large or have too many differences to show the changed lines,
+	// binary and ROOT files
+	kind := "Files"
+	switch d.Type {
+	case fileTypeBinary:
+		kind = "Binary files"
+	case fileTypeROOT:
+		kind = "ROOT files"
+	}
+	return []string{
+		fmt.Sprintf("%s %s and %s differ", kind, nameA, nameB),
+		sizes,
+		fmt.Sprintf("  checksum: %s -> %s", d.ChecksumA, d.ChecksumB),
+	}
+}
+
+// changedFiles returns whether any of the compared files is different.
+func changedFiles(diffs []fileDiff) bool {
+	for _, d := range diffs {
+		if d.Status != fileIdentical && d.Status != fileSameSize {
+			return true
+		}
+	}
+	return false
+}
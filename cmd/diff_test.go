@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -10,10 +10,16 @@ package cmd
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
+	"reanahub/reana-client-go/pkg/config"
 	"reanahub/reana-client-go/pkg/datautils"
 	"reanahub/reana-client-go/pkg/displayer"
+	"strings"
 	"testing"
 
 	"github.com/jedib0t/go-pretty/v6/text"
@@ -109,6 +115,155 @@ func TestDiff(t *testing.T) {
 			expected:  []string{"accepts 2 arg(s), received 1"},
 			wantError: true,
 		},
+		"workflow without local directory": {
+			args:      []string{workflowA, workflowB, "-w", workflowA},
+			expected:  []string{"--workflow can only be used together with --local"},
+			wantError: true,
+		},
+		"json output": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(diffPathTemplate, workflowA, workflowB): {
+					statusCode:   http.StatusOK,
+					responseFile: "diff_complete.json",
+				},
+			},
+			args: []string{workflowA, workflowB, "--json"},
+			expected: []string{
+				`"reana_specification": {`,
+				`"version": [`, `"- v0.1",`, `"specification": [`,
+				`"workspace_listing": [`, `"Only in my_workflow_a: test.yaml"`,
+			},
+			unwanted: []string{`"workflow": [`, "Differences in workflow"},
+		},
+		"compare file contents": {
+			serverResponses: diffFilesServerResponses(workflowA, workflowB),
+			args:            []string{workflowA, workflowB, "--files", "**"},
+			expected: []string{
+				"No differences in REANA specifications",
+				"Differences in workflow files",
+				"Only in my_workflow_a: only_a.txt",
+				"Only in my_workflow_b: only_b.txt",
+				"--- my_workflow_a/code/fit.py", "+++ my_workflow_b/code/fit.py",
+				"@@ -1,4 +1,4 @@", "-x = 1", "+x = 2",
+				"Binary files my_workflow_a/results/plot.png and my_workflow_b/results/plot.png differ",
+				"size: 18 -> 19 bytes",
+				"ROOT files my_workflow_a/results/data.root and my_workflow_b/results/data.root differ",
+				"checksum: sha256:",
+			},
+			unwanted: []string{"Differences in workflow workspace"},
+		},
+		"compare file contents matching a pattern": {
+			serverResponses: diffFilesServerResponses(workflowA, workflowB),
+			args:            []string{workflowA, workflowB, "--files", "results/*.root"},
+			expected:        []string{"ROOT files"},
+			unwanted:        []string{"only_a.txt", "fit.py", "plot.png"},
+		},
+		"compare file contents in json": {
+			serverResponses: diffFilesServerResponses(workflowA, workflowB),
+			args:            []string{workflowA, workflowB, "--files", "code/*", "--json"},
+			expected: []string{
+				`"reana_specification": {`,
+				`"source_a": "my_workflow_a"`, `"source_b": "my_workflow_b"`,
+				`"path": "code/fit.py"`, `"status": "modified"`, `"type": "text"`,
+				`"size_a": 29`, `"checksum_b": "sha256:`, `"+x = 2"`,
+			},
+			unwanted: []string{`"workspace_listing"`, "plot.png"},
+		},
+		"invalid files pattern": {
+			serverResponses: diffFilesServerResponses(workflowA, workflowB),
+			args:            []string{workflowA, workflowB, "--files", "[a"},
+			expected:        []string{"invalid pattern '[a'"},
+			wantError:       true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "diff"
+			testCmdRun(t, params)
+		})
+	}
+}
+
+func TestDiffLocal(t *testing.T) {
+	workflow := "my_workflow_b"
+	dir := t.TempDir()
+	files := map[string]string{
+		"code/fit.py":  "../testdata/inputs/diff_files_fit_a.py",
+		"only_a.txt":   "",
+		".git/HEAD":    "",
+		"results/logs": "",
+	}
+	for name, source := range files {
+		content := []byte("local")
+		if source != "" {
+			var err error
+			if content, err = os.ReadFile(source); err != nil {
+				t.Fatal(err)
+			}
+		}
+		filePath := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filePath, content, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	label := filepath.ToSlash(dir)
+
+	tests := map[string]TestCmdParams{
+		"compare sizes": {
+			serverResponses: diffFilesServerResponses("my_workflow_a", workflow),
+			args:            []string{"--local", dir, "-w", workflow},
+			expected: []string{
+				"Differences in workflow files",
+				"Only in " + label + ": only_a.txt",
+				"Only in " + label + ": results/logs",
+				"Only in my_workflow_b: results/plot.png",
+			},
+			unwanted: []string{"REANA specifications", ".git", "fit.py"},
+		},
+		"compare contents": {
+			serverResponses: diffFilesServerResponses("my_workflow_a", workflow),
+			args:            []string{"--local", dir, "-w", workflow, "--files", "code"},
+			expected: []string{
+				"--- " + label + "/code/fit.py", "+++ my_workflow_b/code/fit.py",
+				"-x = 1", "+x = 2",
+			},
+			unwanted: []string{"only_a.txt"},
+		},
+		"identical contents": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflow): {
+					statusCode:   http.StatusOK,
+					responseFile: "diff_files_ls_a.json",
+				},
+				fmt.Sprintf(downloadServerPath, workflow, "code/fit.py"): {
+					statusCode:      http.StatusOK,
+					responseFile:    "diff_files_fit_a.py",
+					responseHeaders: map[string]string{"Content-Type": "application/octet-stream"},
+				},
+			},
+			args:     []string{"--local", dir, "-w", workflow, "--files", "code/fit.py"},
+			expected: []string{"No differences in workflow files."},
+			unwanted: []string{"Differences in workflow files"},
+		},
+		"local directory and workflows": {
+			args:      []string{"--local", dir, "my_workflow_a", workflow},
+			expected:  []string{"please provide either two workflows or --local, not both"},
+			wantError: true,
+		},
+		"missing workflow": {
+			args:      []string{"--local", dir},
+			expected:  []string{"workflow name must be provided"},
+			wantError: true,
+		},
+		"not a directory": {
+			args:      []string{"--local", filepath.Join(dir, "only_a.txt"), "-w", workflow},
+			expected:  []string{"only_a.txt is not a directory"},
+			wantError: true,
+		},
 	}
 
 	for name, params := range tests {
@@ -119,6 +274,105 @@ func TestDiff(t *testing.T) {
 	}
 }
 
+// diffFilesServerResponses returns the responses of the server to compare the files of two workflows.
+func TestReadFileContent(t *testing.T) {
+	oldMaxTextSize := config.DiffMaxTextSize
+	config.DiffMaxTextSize = 16
+	t.Cleanup(func() { config.DiffMaxTextSize = oldMaxTextSize })
+
+	dir := t.TempDir()
+	files := map[string][]byte{
+		"small.txt": []byte("x = 1\n"),
+		"large.txt": bytes.Repeat([]byte("x = 1\n"), 2000),
+		"data.bin":  append([]byte("\x00\x01"), bytes.Repeat([]byte("x"), 20000)...),
+		"empty.txt": {},
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := map[string]struct {
+		fileType string
+		keepText bool
+	}{
+		"small.txt": {fileType: fileTypeText, keepText: true},
+		"large.txt": {fileType: fileTypeText},
+		"data.bin":  {fileType: fileTypeBinary},
+		"empty.txt": {fileType: fileTypeText, keepText: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			content, err := readFileContent(localDiffSource{dir: dir}, name)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			sum := sha256.Sum256(files[name])
+			if content.size != int64(len(files[name])) {
+				t.Errorf("expected size %d, got %d", len(files[name]), content.size)
+			}
+			if content.checksum != "sha256:"+hex.EncodeToString(sum[:]) {
+				t.Errorf("unexpected checksum %s", content.checksum)
+			}
+			if content.fileType != test.fileType {
+				t.Errorf("expected type %s, got %s", test.fileType, content.fileType)
+			}
+			if test.keepText && !bytes.Equal(content.text, files[name]) {
+				t.Errorf("expected text to be kept, got %q", content.text)
+			}
+			if !test.keepText && content.text != nil {
+				t.Errorf("expected text not to be kept, got %d bytes", len(content.text))
+			}
+		})
+	}
+
+	// Text files too large to be kept are compared by their size and checksum
+	otherDir := t.TempDir()
+	modified := append(bytes.Repeat([]byte("x = 1\n"), 1999), []byte("x = 2\n")...)
+	if err := os.WriteFile(filepath.Join(otherDir, "large.txt"), modified, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	diffs, err := diffFiles(
+		localDiffSource{dir: dir}, localDiffSource{dir: otherDir}, "large.txt", true, 3,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	lines := diffs[0].lines("a", "b")
+	if len(lines) != 3 || lines[0] != "Files a/large.txt and b/large.txt differ" ||
+		!strings.HasPrefix(lines[2], "  checksum: sha256:") {
+		t.Errorf("expected size and checksum summary, got %q", lines)
+	}
+}
+
+func diffFilesServerResponses(workflowA, workflowB string) map[string]ServerResponse {
+	responses := map[string]ServerResponse{
+		fmt.Sprintf(diffPathTemplate, workflowA, workflowB): {
+			statusCode:   http.StatusOK,
+			responseFile: "diff_same_spec.json",
+		},
+	}
+	for workflow, suffix := range map[string]string{workflowA: "a", workflowB: "b"} {
+		responses[fmt.Sprintf(lsPathTemplate, workflow)] = ServerResponse{
+			statusCode:   http.StatusOK,
+			responseFile: fmt.Sprintf("diff_files_ls_%s.json", suffix),
+		}
+		for name, file := range map[string]string{
+			"code/fit.py":       "diff_files_fit_%s.py",
+			"results/plot.png":  "diff_files_plot_%s.png",
+			"results/data.root": "diff_files_data_%s.root",
+		} {
+			responses[fmt.Sprintf(downloadServerPath, workflow, name)] = ServerResponse{
+				statusCode:      http.StatusOK,
+				responseFile:    fmt.Sprintf(file, suffix),
+				responseHeaders: map[string]string{"Content-Type": "application/octet-stream"},
+			}
+		}
+	}
+	return responses
+}
+
 func TestPrintDiff(t *testing.T) {
 	tests := map[string]struct {
 		lines          []string
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2023, 2025 CERN.
+Copyright (C) 2022, 2023, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"io"
 	"path"
+	"reanahub/reana-client-go/client"
 	"reanahub/reana-client-go/pkg/config"
 	"reanahub/reana-client-go/pkg/displayer"
 	"reanahub/reana-client-go/pkg/fileutils"
@@ -30,7 +31,9 @@ Download workspace files.
 The ` + "``download``" + ` command allows to download workspace files and directories.
 By default, the files specified in the workflow specification as outputs are
 downloaded. You can also specify the individual files you would like to
-download, see examples below.
+download, see examples below. Glob patterns are supported: '*' matches any
+characters except '/', '**' matches any characters including '/', and braces
+list alternatives, e.g. '{a,b}'.
 
 Examples:
 
@@ -39,6 +42,10 @@ Examples:
   $ reana-client download mydata.tmp outputs/myplot.png
 
   $ reana-client download -o - data.txt # write data.txt to stdout
+
+  $ reana-client download 'results/**/*.{png,pdf}'
+
+  $ reana-client download -w myanalysis.42 --shared-by alice@cern.ch
 `
 
 const outputPathFlagDesc = `Path to the directory where files will be downloaded.
@@ -48,6 +55,7 @@ type downloadOptions struct {
 	token      string
 	workflow   string
 	outputPath string
+	sharedBy   string
 }
 
 // newDownloadCmd creates a command to download workspace files.
@@ -86,16 +94,46 @@ func newDownloadCmd() *cobra.Command {
 		"",
 		outputPathFlagDesc,
 	)
+	f.StringVar(
+		&o.sharedBy,
+		"shared-by",
+		"",
+		`Email of the user who shared the workflow
+with you, to access a workflow you do not own.`,
+	)
+
+	cmd.ValidArgsFunction = completeWorkspacePaths
 
 	return cmd
 }
 
 func (o *downloadOptions) run(cmd *cobra.Command, args []string) error {
+	if o.sharedBy != "" {
+		workflowID, err := getSharedWorkflowID(o.token, o.sharedBy, o.workflow)
+		if err != nil {
+			return err
+		}
+		o.workflow = workflowID
+	}
+
 	var downloadPaths []string
 
 	if len(args) > 0 {
-		// download files and directories specified in arguments.
-		downloadPaths = args
+		// download files and directories specified in arguments, expanding glob patterns.
+		api, err := client.ApiClient()
+		if err != nil {
+			return err
+		}
+		resolvedPaths, err := resolveWorkspacePatterns(api, o.token, o.workflow, args)
+		if err != nil {
+			return err
+		}
+		for i, paths := range resolvedPaths {
+			if len(paths) == 0 {
+				return fmt.Errorf("%s did not match any existing file", args[i])
+			}
+			downloadPaths = append(downloadPaths, paths...)
+		}
 	} else {
 		// download all output files and directories specified in the reana.yaml file.
 		spec, err := workflows.GetWorkflowSpecification(o.token, o.workflow)
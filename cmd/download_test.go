@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2023, 2025 CERN.
+Copyright (C) 2022, 2023, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -106,6 +106,39 @@ func TestFileDownload(t *testing.T) {
 				"file does not exist.",
 			},
 		},
+		"download files matching a glob pattern": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, "my_workflow"): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+				fmt.Sprintf(downloadServerPath, "my_workflow", "results/data.root"): {
+					statusCode:   http.StatusOK,
+					responseFile: "common_empty.json",
+					responseHeaders: map[string]string{
+						"Content-Disposition": `attachment; filename="results/data.root"`,
+					},
+				},
+			},
+			args: []string{"-w", "my_workflow", "**/*.{root,png}"},
+			expected: []string{
+				"results/data.root was successfully downloaded.",
+			},
+			unwanted: []string{"gendata.C"},
+		},
+		"glob pattern without matching files": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, "my_workflow"): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+			},
+			args:      []string{"-w", "my_workflow", "*.txt"},
+			wantError: true,
+			expected: []string{
+				"*.txt did not match any existing file",
+			},
+		},
 		"unexisting workflow": {
 			args:      []string{},
 			wantError: true,
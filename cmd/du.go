@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2023, 2025 CERN.
+Copyright (C) 2022, 2023, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -16,7 +16,12 @@ import (
 	"reanahub/reana-client-go/pkg/datautils"
 	"reanahub/reana-client-go/pkg/displayer"
 	"reanahub/reana-client-go/pkg/filterer"
+	"reanahub/reana-client-go/pkg/formatter"
+	"reanahub/reana-client-go/pkg/glob"
+	"strconv"
 
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
 	"github.com/spf13/cobra"
 )
 
@@ -24,6 +29,11 @@ const duDesc = `
 Get workspace disk usage.
 
 The ` + "``du``" + ` command allows to check the disk usage of given workspace.
+The optional PATTERN arguments restrict the disk usage to the files they select,
+using glob patterns: '*' matches any characters except '/', '**' matches any
+characters including '/', and braces list alternatives, e.g. '{a,b}'. The files
+are listed in the order given by the server, unless they are sorted by name or
+size with ` + "``--sort``" + `.
 
 Examples:
 
@@ -32,19 +42,27 @@ Examples:
   $ reana-client du -w myanalysis.42 -s --human-readable
 
   $ reana-client du -w myanalysis.42 --filter name=data/
+
+  $ reana-client du -w myanalysis.42 --sort -size -h
+
+  $ reana-client du -w myanalysis.42 -s 'results/**/*.root'
 `
 
 const duFilterFlagDesc = `Filter results to show only files that match certain filtering
 criteria such as file name or size.
 Use --filter <columm_name>=<column_value> pairs.
-Available filters are 'name' and 'size'.`
+Available filters are 'name' and 'size'. Filter expressions
+such as 'size>=10MiB' or 'name~\.root$ or size>1GiB' can
+also use the operators '!=', '>', '>=', '<', '<=', '~' and '!~'.`
 
 type duOptions struct {
-	token         string
-	workflow      string
-	summarize     bool
-	humanReadable bool
-	filter        []string
+	token     string
+	workflow  string
+	summarize bool
+	filter    []string
+	patterns  []string
+	sortOptions
+	sizeOptions
 }
 
 // newDuCmd creates a command to get workspace disk usage.
@@ -52,11 +70,15 @@ func newDuCmd() *cobra.Command {
 	o := &duOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "du",
+		Use:   "du [PATTERN...]",
 		Short: "Get workspace disk usage.",
 		Long:  duDesc,
-		Args:  cobra.NoArgs,
+		Args:  cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			o.patterns = args
+			if err := o.validateSizeFlags(); err != nil {
+				return err
+			}
 			return o.run(cmd)
 		},
 	}
@@ -77,22 +99,21 @@ func newDuCmd() *cobra.Command {
 		"Name or UUID of the workflow. Overrides value of REANA_WORKON environment variable.",
 	)
 	f.BoolVarP(&o.summarize, "summarize", "s", false, "Display total.")
-	f.BoolVarP(
-		&o.humanReadable,
-		"human-readable",
-		"h",
-		false,
-		"Show disk size in human readable format.",
-	)
+	addSizeFlags(f, &o.sizeOptions, "Show disk size in human readable format.", false)
 	f.StringSliceVar(&o.filter, "filter", []string{}, duFilterFlagDesc)
+	addSortFlag(f, &o.sortOptions, []string{})
 	// Remove -h shorthand
 	cmd.PersistentFlags().BoolP("help", "", false, "Help for du")
 
+	cmd.ValidArgsFunction = completeWorkspacePaths
+	registerFlagCompletion(cmd, "filter", completeFilters(config.DuMultiFilters, nil))
+	registerFlagCompletion(cmd, "sort", completeSortColumns(config.DuMultiFilters))
+
 	return cmd
 }
 
 func (o *duOptions) run(cmd *cobra.Command) error {
-	filters, err := filterer.NewFilters(nil, config.DuMultiFilters, o.filter)
+	filters, err := filterer.NewTypedFilters(nil, config.DuMultiFilters, duFilterTypes, o.filter)
 	if err != nil {
 		return err
 	}
@@ -100,12 +121,23 @@ func (o *duOptions) run(cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
+	patterns, err := glob.CompileAll(o.patterns)
+	if err != nil {
+		return err
+	}
+	sortKeys, err := o.sortKeys()
+	if err != nil {
+		return err
+	}
 
 	duParams := operations.NewGetWorkflowDiskUsageParams()
 	duParams.SetAccessToken(&o.token)
 	duParams.SetWorkflowIDOrName(o.workflow)
+	selectLocally := len(patterns) > 0 || filters.HasExpressions()
 	additionalParams := operations.GetWorkflowDiskUsageBody{
-		Summarize: o.summarize,
+		// The files are needed to select those matching the patterns and the filter expressions,
+		// the total is then computed locally.
+		Summarize: o.summarize && !selectLocally,
 		Search:    searchFilter,
 	}
 	duParams.SetParameters(additionalParams)
@@ -119,18 +151,102 @@ func (o *duOptions) run(cmd *cobra.Command) error {
 		return err
 	}
 
-	err = displayDuPayload(cmd, duResp.Payload, o.humanReadable)
+	payload := duResp.Payload
+	if selectLocally {
+		payload = selectDiskUsage(payload, func(name string, size int64) bool {
+			values := map[string]string{"name": name, "size": strconv.FormatInt(size, 10)}
+			return (len(patterns) == 0 || glob.SelectAny(patterns, name)) && filters.Match(values)
+		}, o.summarize)
+	}
+	payload, err = sortDiskUsage(payload, sortKeys)
+	if err != nil {
+		return err
+	}
+	err = displayDuPayload(cmd, payload, o.sizeOptions)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-// displayDuPayload displays the disk usage payload, according to the humanReadable flag.
+// duFilterTypes types of the file fields compared in filter expressions.
+var duFilterTypes = map[string]filterer.ValueType{"size": filterer.SizeValue}
+
+// selectDiskUsage returns the disk usage of the files selected by keep, given their name and size,
+// or their total size if summarize is true.
+func selectDiskUsage(
+	p *operations.GetWorkflowDiskUsageOKBody,
+	keep func(name string, size int64) bool,
+	summarize bool,
+) *operations.GetWorkflowDiskUsageOKBody {
+	selected := *p
+	selected.DiskUsageInfo = nil
+	var total int64
+	for _, diskUsageInfo := range p.DiskUsageInfo {
+		var size int64
+		if diskUsageInfo.Size != nil {
+			size = diskUsageInfo.Size.Raw
+		}
+		if !keep(diskUsageInfo.Name, size) ||
+			datautils.HasAnyPrefix(diskUsageInfo.Name, config.FilesBlacklist) {
+			continue
+		}
+		selected.DiskUsageInfo = append(selected.DiskUsageInfo, diskUsageInfo)
+		total += size
+	}
+	if summarize && len(selected.DiskUsageInfo) > 0 {
+		selected.DiskUsageInfo = []*operations.GetWorkflowDiskUsageOKBodyDiskUsageInfoItems0{{
+			Size: &operations.GetWorkflowDiskUsageOKBodyDiskUsageInfoItems0Size{
+				HumanReadable: datautils.FormatByteSize(total),
+				Raw:           total,
+			},
+		}}
+	}
+	return &selected
+}
+
+// sortDiskUsage returns the disk usage of the files sorted by the given keys, which can be their name and size.
+func sortDiskUsage(
+	p *operations.GetWorkflowDiskUsageOKBody,
+	sortKeys []formatter.SortKey,
+) (*operations.GetWorkflowDiskUsageOKBody, error) {
+	if len(sortKeys) == 0 {
+		return p, nil
+	}
+	var names []string
+	var sizes, indexes []int
+	for i, diskUsageInfo := range p.DiskUsageInfo {
+		var size int64
+		if diskUsageInfo.Size != nil {
+			size = diskUsageInfo.Size.Raw
+		}
+		names = append(names, diskUsageInfo.Name)
+		sizes = append(sizes, int(size))
+		indexes = append(indexes, i)
+	}
+	df, err := formatter.SortDataFrame(dataframe.New(
+		series.New(names, series.String, "name"),
+		series.New(sizes, series.Int, "size"),
+		series.New(indexes, series.Int, "index"),
+	), sortKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := *p
+	sorted.DiskUsageInfo = nil
+	sortedIndexes, _ := df.Col("index").Int()
+	for _, i := range sortedIndexes {
+		sorted.DiskUsageInfo = append(sorted.DiskUsageInfo, p.DiskUsageInfo[i])
+	}
+	return &sorted, nil
+}
+
+// displayDuPayload displays the disk usage payload, with sizes formatted according to the size flags.
 func displayDuPayload(
 	cmd *cobra.Command,
 	p *operations.GetWorkflowDiskUsageOKBody,
-	humanReadable bool,
+	sizes sizeOptions,
 ) error {
 	if len(p.DiskUsageInfo) == 0 {
 		return errors.New("no files matching filter criteria")
@@ -144,14 +260,10 @@ func displayDuPayload(
 			continue
 		}
 
-		var row []any
-		if humanReadable {
-			row = append(row, diskUsageInfo.Size.HumanReadable)
-		} else {
-			row = append(row, diskUsageInfo.Size.Raw)
-		}
-		row = append(row, "."+diskUsageInfo.Name)
-		rows = append(rows, row)
+		rows = append(rows, []any{
+			sizes.formatSize(diskUsageInfo.Size.Raw),
+			"." + diskUsageInfo.Name,
+		})
 	}
 
 	displayer.DisplayTable(header, rows, cmd.OutOrStdout())
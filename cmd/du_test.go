@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -60,6 +60,19 @@ func TestDu(t *testing.T) {
 				"4.5 KiB", "./code/gendata.C",
 			},
 		},
+		"human readable in SI units": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(duPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "du_regular_files.json",
+				},
+			},
+			args: []string{"-w", workflowName, "-h", "--units", "si"},
+			expected: []string{
+				"2.05 kB", "./code/fitdata.C",
+				"4.61 kB", "./code/gendata.C",
+			},
+		},
 		"files in black list": {
 			serverResponses: map[string]ServerResponse{
 				fmt.Sprintf(duPathTemplate, workflowName): {
@@ -130,6 +143,93 @@ func TestDu(t *testing.T) {
 			},
 			wantError: true,
 		},
+		"glob patterns": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(duPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "du_regular_files.json",
+				},
+			},
+			args: []string{"-w", workflowName, "code/gen*"},
+			expected: []string{
+				"SIZE", "NAME",
+				"4608", "./code/gendata.C",
+			},
+			unwanted: []string{"fitdata.C"},
+		},
+		"glob patterns summarized": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(duPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "du_regular_files.json",
+				},
+			},
+			args: []string{"-w", workflowName, "-s", "-h", "**/*.C"},
+			expected: []string{
+				"SIZE", "NAME",
+				"6.5 KiB", ".",
+			},
+			unwanted: []string{"gendata.C"},
+		},
+		"filter expression": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(duPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "du_regular_files.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "--filter", "size>3KiB"},
+			expected: []string{"4608", "./code/gendata.C"},
+			unwanted: []string{"fitdata.C"},
+		},
+		"filter expression summarized": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(duPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "du_regular_files.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "-s", "--filter", "name~fit or size<1KiB"},
+			expected: []string{"2048", "."},
+			unwanted: []string{"fitdata.C", "4608"},
+		},
+		"invalid filter expression": {
+			args:      []string{"-w", workflowName, "--filter", "size>big"},
+			expected:  []string{"invalid filter 'size>big': invalid value for 'size': invalid size 'big'"},
+			wantError: true,
+		},
+		"sorted by descending size": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(duPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "du_regular_files.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "--sort", "-size"},
+			expected: []string{"4608   ./code/gendata.C\n2048   ./code/fitdata.C"},
+		},
+		"invalid sort column": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(duPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "du_regular_files.json",
+				},
+			},
+			args:      []string{"-w", workflowName, "--sort", "modified"},
+			expected:  []string{"column 'modified' does not exist"},
+			wantError: true,
+		},
+		"glob patterns without matching files": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(duPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "du_regular_files.json",
+				},
+			},
+			args:      []string{"-w", workflowName, "*.root"},
+			expected:  []string{"no files matching filter criteria"},
+			wantError: true,
+		},
 	}
 
 	for name, params := range tests {
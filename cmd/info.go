@@ -27,11 +27,15 @@ The ` + "``info``" + ` command lists general information about the cluster.
 
 Lists all the available workspaces. It also returns the default workspace
 defined by the admin.
+
+The cluster information is cached for a short time. Use ` + "``--refresh``" + ` to
+retrieve it from the server.
 `
 
 type infoOptions struct {
 	token      string
 	jsonOutput bool
+	refresh    bool
 }
 
 // newInfoCmd creates a command to list cluster general information.
@@ -57,13 +61,17 @@ func newInfoCmd() *cobra.Command {
 		"Access token of the current user.",
 	)
 	f.BoolVarP(&o.jsonOutput, "json", "", false, "Get output in JSON format.")
+	f.BoolVar(
+		&o.refresh,
+		"refresh",
+		false,
+		"Retrieve the information from the server instead of using the cached one.",
+	)
 
 	return cmd
 }
 
 func (o *infoOptions) run(cmd *cobra.Command) error {
-	infoParams := operations.NewInfoParams()
-	infoParams.SetAccessToken(o.token)
 	quotaParams := operations.NewGetYouParams()
 	quotaParams.SetAccessToken(&o.token)
 
@@ -71,7 +79,7 @@ func (o *infoOptions) run(cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
-	infoResp, err := api.Operations.Info(infoParams)
+	p, err := getServerInfo(api, o.token, o.refresh)
 	if err != nil {
 		return err
 	}
@@ -91,7 +99,6 @@ func (o *infoOptions) run(cmd *cobra.Command) error {
 		}
 	}
 
-	p := infoResp.Payload
 	if o.jsonOutput {
 		infoMap, err := buildInfoOutputMap(p, quotaPeriodInfo)
 		if err != nil {
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2023, 2024, 2025 CERN.
+Copyright (C) 2022, 2023, 2024, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -15,10 +15,13 @@ import (
 	"reanahub/reana-client-go/client/operations"
 	"reanahub/reana-client-go/pkg/config"
 	"reanahub/reana-client-go/pkg/datautils"
-	"reanahub/reana-client-go/pkg/displayer"
 	"reanahub/reana-client-go/pkg/filterer"
 	"reanahub/reana-client-go/pkg/formatter"
+	"reanahub/reana-client-go/pkg/paginator"
+	"reanahub/reana-client-go/pkg/sessiontypes"
 	"reanahub/reana-client-go/pkg/workflows"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/go-gota/gota/dataframe"
@@ -26,6 +29,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
 )
 
 const listFormatFlagDesc = `Format output according to column titles or column
@@ -36,7 +40,12 @@ E.g. display workflow with failed status and named test_workflow
 const listFilterFlagDesc = `Filter workflow that contains certain filtering
 criteria. Use --filter
 <columm_name>=<column_value> pairs. Available
-filters are 'name' and 'status'.`
+filters are 'name' and 'status'. Filter expressions
+such as 'created>=2026-01-01 and size>1GiB' or
+'name~^test- or status!=failed' can also use the
+operators '!=', '>', '>=', '<', '<=', '~' and '!~'
+on 'run_number', 'created', 'started', 'ended',
+'id', 'user', 'size' and 'duration'.`
 
 const listDesc = `List all workflows and sessions.
 
@@ -57,6 +66,21 @@ values to the ` + "``--shared-by``" + ` and ` + "``--shared-with``" + ` command-
 
   - ` + "``--shared-with bob@cern.ch``" + `: list workflows shared with bob@cern.ch
 
+All the workflows are listed, unless a page is requested with ` + "``--page``" + ` or
+` + "``--size``" + ` without ` + "``--all-pages``" + `. When sorting by descending creation
+date, which is the default, the workflows are displayed as soon as each page is
+retrieved, and ` + "``--limit``" + ` stops after the given number of workflows.
+
+The workflows can be sorted by several columns with ` + "``--sort``" + `, prefixing with
+a dash the ones to sort in descending order. As in previous versions, a single
+column without prefix is sorted in descending order too, e.g. ` + "``--sort run_number``" + `,
+whereas ` + "``--sort +run_number``" + ` sorts it in ascending order. Dates, durations, sizes
+and run numbers are compared by value, e.g. run 1.10 comes after run 1.9.
+
+The displayed columns can be chosen, renamed and computed with ` + "``--columns``" + `, and
+the workflows can be aggregated with ` + "``--agg``" + `, possibly by group of workflows
+sharing the values of the ` + "``--group-by``" + ` columns.
+
 Examples:
 
   $ reana-client list --all
@@ -65,6 +89,16 @@ Examples:
 
   $ reana-client list --verbose --bytes
 
+  $ reana-client list --include-workspace-size -h --units si
+
+  $ reana-client list --limit 10
+
+  $ reana-client list --sort status,-created,run_number
+
+  $ reana-client list --columns name:Workflow,status,duration_h=duration/3600
+
+  $ reana-client list --group-by status --agg count,sum(size)
+
   $ reana-client list --shared
 
   $ reana-client list --shared-by bob@cern.ch
@@ -81,8 +115,6 @@ type listOptions struct {
 	jsonOutput           bool
 	showAll              bool
 	verbose              bool
-	humanReadable        bool
-	sortColumn           string
 	filters              []string
 	includeDuration      bool
 	includeProgress      bool
@@ -93,6 +125,10 @@ type listOptions struct {
 	shared               bool
 	shared_by            string
 	shared_with          string
+	paginationOptions
+	tableOptions
+	sortOptions
+	sizeOptions
 }
 
 // newListCmd creates a new command for listing workflows and sessions.
@@ -106,6 +142,9 @@ func newListCmd() *cobra.Command {
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			o.serverURL = viper.GetString("server-url")
+			if err := o.validateSizeFlags(); err != nil {
+				return err
+			}
 			return o.run(cmd)
 		},
 	}
@@ -148,19 +187,9 @@ func newListCmd() *cobra.Command {
 		`Print out extra information: workflow id, user id, disk usage,
 progress, duration.`,
 	)
-	f.BoolVarP(
-		&o.humanReadable,
-		"human-readable",
-		"h",
-		false,
-		"Show disk size in human readable format.",
-	)
-	f.StringVar(
-		&o.sortColumn,
-		"sort",
-		"CREATED",
-		"Sort the output by specified column.",
-	)
+	addSizeFlags(f, &o.sizeOptions, "Show disk size in human readable format.", false)
+	o.singleKeyDescending = true
+	addSortFlag(f, &o.sortOptions, []string{"-created"})
 	f.StringSliceVar(&o.filters, "filter", []string{}, listFilterFlagDesc)
 	f.BoolVar(
 		&o.includeDuration,
@@ -199,6 +228,8 @@ In case a workflow is in progress, its duration as of now will be shown.`,
 		0,
 		"Number of results per page (to be used with --page).",
 	)
+	addPaginationFlags(f, &o.paginationOptions)
+	addTableFlags(f, &o.tableOptions)
 	f.BoolVar(
 		&o.shared,
 		"shared",
@@ -224,6 +255,15 @@ In case a workflow is in progress, its duration as of now will be shown.`,
 	if err != nil {
 		log.Debugf("Failed to set workflow annotation: %s", err.Error())
 	}
+	registerFlagCompletion(cmd, "filter", completeFilters(
+		config.ListMultiFilters,
+		map[string][]string{"status": config.GetRunStatuses(true)},
+	))
+	registerFlagCompletion(cmd, "format", completeColumns(listColumns))
+	registerFlagCompletion(cmd, "columns", completeColumns(listColumns))
+	registerFlagCompletion(cmd, "group-by", completeColumns(listColumns))
+	registerFlagCompletion(cmd, "sort", completeSortColumns(listColumns))
+
 	return cmd
 }
 
@@ -241,7 +281,7 @@ func (o *listOptions) run(cmd *cobra.Command) error {
 		runType = "batch"
 	}
 
-	statusFilters, searchFilter, err := parseListFilters(
+	statusFilters, searchFilter, filters, err := parseListFilters(
 		o.filters,
 		o.showDeletedRuns,
 		o.showAll,
@@ -250,42 +290,73 @@ func (o *listOptions) run(cmd *cobra.Command) error {
 		return err
 	}
 
-	listParams := operations.NewGetWorkflowsParams()
-	listParams.SetAccessToken(&o.token)
-	listParams.SetType(runType)
-	listParams.SetVerbose(&o.verbose)
-	listParams.SetPage(&o.page)
-	listParams.SetWorkflowIDOrName(&o.workflow)
-	listParams.SetStatus(statusFilters)
-	listParams.SetSearch(&searchFilter)
-	if cmd.Flags().Changed("size") {
-		listParams.SetSize(&o.size)
-	}
-	// Don't set these to false because they override the server's verbose flag
-	if cmd.Flags().Changed("include-progress") {
-		listParams.SetIncludeProgress(&o.includeProgress)
-	}
-	if cmd.Flags().Changed("include-workspace-size") {
-		listParams.SetIncludeWorkspaceSize(&o.includeWorkspaceSize)
-	}
-	if cmd.Flags().Changed("shared") {
-		listParams.SetShared(&o.shared)
+	paginatorOptions, err := o.paginatorOptions(cmd, o.page, o.size, config.WorkflowsPageSize)
+	if err != nil {
+		return err
 	}
-	if cmd.Flags().Changed("shared-by") {
-		listParams.SetSharedBy(&o.shared_by)
+	report, err := o.report(o.formatFilters)
+	if err != nil {
+		return err
 	}
-	if cmd.Flags().Changed("shared-with") {
-		listParams.SetSharedWith(&o.shared_with)
+	sortKeys, err := o.sortKeys()
+	if err != nil {
+		return err
 	}
 
 	api, err := client.ApiClient()
 	if err != nil {
 		return err
 	}
-	listResp, err := api.Operations.GetWorkflows(listParams)
-	if err != nil {
-		return err
+	for _, flag := range []string{"shared", "shared-by", "shared-with"} {
+		if !cmd.Flags().Changed(flag) {
+			continue
+		}
+		if err := requireServerFeature(api, o.token, "sharing", "--"+flag); err != nil {
+			return err
+		}
 	}
+	// The fields compared in filter expressions must be retrieved even if they are not displayed.
+	includeProgress := o.includeProgress || filtersUse(filters, "started", "ended", "duration")
+	includeWorkspaceSize := o.includeWorkspaceSize || filtersUse(filters, "size") ||
+		slices.Contains(report.References(), "size")
+	workflowsPaginator := paginator.New(paginatorOptions, func(page, size int64) (
+		[]*operations.GetWorkflowsOKBodyItemsItems0, int64, error,
+	) {
+		listParams := operations.NewGetWorkflowsParams()
+		listParams.SetAccessToken(&o.token)
+		listParams.SetType(runType)
+		listParams.SetVerbose(&o.verbose)
+		listParams.SetPage(&page)
+		listParams.SetWorkflowIDOrName(&o.workflow)
+		listParams.SetStatus(statusFilters)
+		listParams.SetSearch(&searchFilter)
+		if size > 0 {
+			listParams.SetSize(&size)
+		}
+		// Don't set these to false because they override the server's verbose flag
+		if cmd.Flags().Changed("include-progress") || includeProgress {
+			listParams.SetIncludeProgress(&includeProgress)
+		}
+		if cmd.Flags().Changed("include-workspace-size") || includeWorkspaceSize {
+			listParams.SetIncludeWorkspaceSize(&includeWorkspaceSize)
+		}
+		if cmd.Flags().Changed("shared") {
+			listParams.SetShared(&o.shared)
+		}
+		if cmd.Flags().Changed("shared-by") {
+			listParams.SetSharedBy(&o.shared_by)
+		}
+		if cmd.Flags().Changed("shared-with") {
+			listParams.SetSharedWith(&o.shared_with)
+		}
+		listResp, err := api.Operations.GetWorkflows(listParams)
+		if err != nil {
+			return nil, 0, err
+		}
+		return listResp.Payload.Items, listResp.Payload.Total, nil
+	}).Filter(func(workflow *operations.GetWorkflowsOKBodyItemsItems0) bool {
+		return filters.Match(workflowFilterValues(workflow))
+	})
 
 	header := buildListHeader(
 		runType,
@@ -297,42 +368,115 @@ func (o *listOptions) run(cmd *cobra.Command) error {
 		o.shared_by,
 		o.shared_with,
 	)
+	// The columns used in the report are retrieved even if they are not displayed by default.
+	for _, column := range report.References() {
+		if slices.Contains(listColumns, column) && !slices.Contains(header, column) {
+			header = append(header, column)
+		}
+	}
 	parsedFormatFilters := formatter.ParseFormatParameters(
 		o.formatFilters,
 		true,
 	)
-	err = displayListPayload(
-		cmd,
-		listResp.Payload,
-		header,
-		parsedFormatFilters,
-		o.serverURL,
-		o.token,
-		o.sortColumn,
-		o.jsonOutput,
-		o.humanReadable,
-	)
-	if err != nil {
+	stream := newDataFrameStream(cmd.OutOrStdout(), o.jsonOutput)
+	display := func(items []*operations.GetWorkflowsOKBodyItemsItems0) error {
+		df, err := buildListDataFrame(
+			cmd,
+			items,
+			header,
+			parsedFormatFilters,
+			o.serverURL,
+			o.token,
+			sortKeys,
+			o.sizeOptions,
+		)
+		if err != nil {
+			return err
+		}
+		df, err = report.Apply(df)
+		if err != nil {
+			return err
+		}
+		return stream.append(df)
+	}
+
+	// The server returns the most recent workflows first, so that each page can be displayed as soon
+	// as it is retrieved when sorting by descending creation date only. Otherwise, all the pages are
+	// needed to sort. Grouped rows are aggregated over all the pages.
+	streamPages := len(sortKeys) == 1 &&
+		sortKeys[0] == formatter.SortKey{Column: "created", Descending: true} &&
+		!report.Groups()
+	var allItems []*operations.GetWorkflowsOKBodyItemsItems0
+	for {
+		items, ok, err := workflowsPaginator.NextPage()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		if streamPages {
+			if err := display(items); err != nil {
+				return err
+			}
+		}
+		if !streamPages || o.listSessions {
+			allItems = append(allItems, items...)
+		}
+	}
+	if !streamPages {
+		if err := display(allItems); err != nil {
+			return err
+		}
+	}
+	if err := stream.close(); err != nil {
 		return err
 	}
 
+	if o.listSessions && !o.jsonOutput {
+		displaySessionHints(cmd, getSessionTypes(api, o.token, nil), allItems)
+	}
 	return nil
 }
 
-// displayListPayload displays the list payload, according to the given header, filters and output format.
-func displayListPayload(
+// displaySessionHints displays how to connect to each type of the given interactive sessions.
+func displaySessionHints(
+	cmd *cobra.Command,
+	types []sessiontypes.Type,
+	items []*operations.GetWorkflowsOKBodyItemsItems0,
+) {
+	var sessionTypes []string
+	for _, workflow := range items {
+		if workflow.SessionType != "" && !slices.Contains(sessionTypes, workflow.SessionType) {
+			sessionTypes = append(sessionTypes, workflow.SessionType)
+		}
+	}
+	if len(sessionTypes) == 0 {
+		return
+	}
+
+	sort.Strings(sessionTypes)
+	cmd.Println()
+	for _, sessionType := range sessionTypes {
+		cmd.Printf("%s: %s\n", sessionType, sessiontypes.Hint(types, sessionType))
+	}
+}
+
+// buildListDataFrame returns the data frame of the workflows, sorted and formatted according to the given
+// header, sort keys and filters.
+func buildListDataFrame(
 	cmd *cobra.Command,
-	p *operations.GetWorkflowsOKBody,
+	items []*operations.GetWorkflowsOKBodyItemsItems0,
 	header []string,
 	formatFilters []formatter.FormatFilter,
-	serverURL, token, sortColumn string,
-	jsonOutput, humanReadable bool,
-) error {
+	serverURL, token string,
+	sortKeys []formatter.SortKey,
+	sizes sizeOptions,
+) (dataframe.DataFrame, error) {
 	var df dataframe.DataFrame
-	readableToRaw := make(map[string]int64)
 	for _, col := range header {
-		colSeries := buildListSeries(col, humanReadable)
-		for _, workflow := range p.Items {
+		colSeries := buildListSeries(col, sizes.showHumanReadable())
+		for _, workflow := range items {
 			name, runNumber := workflows.GetNameAndRunNumber(workflow.Name)
 			var value any
 
@@ -342,9 +486,11 @@ func displayListPayload(
 			case "user":
 				value = workflow.User
 			case "size":
-				if humanReadable {
-					value = workflow.Size.HumanReadable
-					readableToRaw[value.(string)] = workflow.Size.Raw
+				if sizes.showHumanReadable() {
+					// A negative size means that it was not computed.
+					if workflow.Size.Raw >= 0 {
+						value = sizes.formatSize(workflow.Size.Raw)
+					}
 				} else {
 					value = int(workflow.Size.Raw)
 				}
@@ -361,7 +507,7 @@ func displayListPayload(
 					workflow.Progress.RunStoppedAt,
 				)
 				if err != nil {
-					return err
+					return dataframe.DataFrame{}, err
 				}
 			case "name":
 				value = name
@@ -401,32 +547,11 @@ func displayListPayload(
 		df = df.CBind(dataframe.New(colSeries))
 	}
 
-	df, err := formatter.SortDataFrame(
-		df,
-		sortColumn,
-		true,
-		readableToRaw,
-		humanReadable,
-	)
+	df, err := formatter.SortDataFrame(df, sortKeys)
 	if err != nil {
 		cmd.PrintErrf("Warning: sort operation was aborted, %s\n", err)
 	}
-	df, err = formatter.FormatDataFrame(df, formatFilters)
-	if err != nil {
-		return err
-	}
-
-	if jsonOutput {
-		err := displayer.DisplayJsonOutput(df.Maps(), cmd.OutOrStdout())
-		if err != nil {
-			return err
-		}
-	} else {
-		data := formatter.DataFrameToStringData(df)
-		displayer.DisplayTable(df.Names(), data, cmd.OutOrStdout())
-	}
-
-	return nil
+	return formatter.FormatDataFrame(df, formatFilters)
 }
 
 // buildListHeader builds the header of the list table, according to the given runType and whether to include
@@ -483,29 +608,45 @@ func buildListHeader(
 	return header
 }
 
+// listFilterTypes types of the workflow fields that can be compared in filter expressions.
+var listFilterTypes = map[string]filterer.ValueType{
+	"run_number":     filterer.NumberValue,
+	"created":        filterer.DateValue,
+	"started":        filterer.DateValue,
+	"ended":          filterer.DateValue,
+	"id":             filterer.StringValue,
+	"user":           filterer.StringValue,
+	"size":           filterer.SizeValue,
+	"duration":       filterer.DurationValue,
+	"session_type":   filterer.StringValue,
+	"session_status": filterer.StringValue,
+}
+
 // parseListFilters takes the filter input and returns status filters as a slice and the remaining filters
-// as a JSON string, according to whether it should show deleted status.
+// as a JSON string, according to whether it should show deleted status. The returned filters evaluate
+// the filter expressions that the server can't, see workflowFilterValues.
 func parseListFilters(
 	filterInput []string,
 	showDeletedRuns, showAll bool,
-) ([]string, string, error) {
-	filters, err := filterer.NewFilters(
+) ([]string, string, filterer.Filters, error) {
+	filters, err := filterer.NewTypedFilters(
 		nil,
 		config.ListMultiFilters,
+		listFilterTypes,
 		filterInput,
 	)
 	if err != nil {
-		return nil, "", err
+		return nil, "", filters, err
 	}
 
 	statusFilters := config.GetRunStatuses(showDeletedRuns || showAll)
 	err = filters.ValidateValues("status", config.GetRunStatuses(true))
 	if err != nil {
-		return nil, "", err
+		return nil, "", filters, err
 	}
 	userStatusFilters, err := filters.GetMulti("status")
 	if err != nil {
-		return nil, "", err
+		return nil, "", filters, err
 	}
 	if len(userStatusFilters) > 0 {
 		statusFilters = userStatusFilters
@@ -514,10 +655,57 @@ func parseListFilters(
 	jsonFilters := datautils.RemoveFromSlice(config.ListMultiFilters, "status")
 	searchFilter, err := filters.GetJson(jsonFilters)
 	if err != nil {
-		return nil, "", err
+		return nil, "", filters, err
 	}
 
-	return statusFilters, searchFilter, nil
+	return statusFilters, searchFilter, filters, nil
+}
+
+// filtersUse returns whether any of the given keys is compared in the filter expressions.
+func filtersUse(filters filterer.Filters, keys ...string) bool {
+	for _, key := range filters.ExpressionKeys() {
+		if slices.Contains(keys, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// workflowFilterValues returns the values of the workflow fields compared in filter expressions.
+func workflowFilterValues(workflow *operations.GetWorkflowsOKBodyItemsItems0) map[string]string {
+	name, runNumber := workflows.GetNameAndRunNumber(workflow.Name)
+	values := map[string]string{
+		"name":       name,
+		"run_number": runNumber,
+		"status":     workflow.Status,
+		"created":    workflow.Created,
+		"id":         workflow.ID,
+		"user":       workflow.User,
+	}
+	if workflow.SessionType != "" {
+		values["session_type"] = workflow.SessionType
+		values["session_status"] = workflow.SessionStatus
+	}
+	if workflow.Size != nil {
+		values["size"] = strconv.FormatInt(workflow.Size.Raw, 10)
+	}
+	if progress := workflow.Progress; progress != nil {
+		if progress.RunStartedAt != nil {
+			values["started"] = *progress.RunStartedAt
+		}
+		if progress.RunFinishedAt != nil {
+			values["ended"] = *progress.RunFinishedAt
+		}
+		duration, err := workflows.GetDuration(
+			progress.RunStartedAt,
+			progress.RunFinishedAt,
+			progress.RunStoppedAt,
+		)
+		if err == nil && duration != nil {
+			values["duration"] = fmt.Sprint(duration)
+		}
+	}
+	return values
 }
 
 // buildListSeries returns a Series of the right type, according to the column name.
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2023, 2024, 2025 CERN.
+Copyright (C) 2022, 2023, 2024, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -40,6 +40,129 @@ func TestList(t *testing.T) {
 				"SESSION_TYPE", "SESSION_URI", "SESSION_STATUS",
 			},
 		},
+		"all pages": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:              http.StatusOK,
+					responseFile:            "list_page_1.json",
+					additionalResponseFiles: []string{"list_page_2.json"},
+				},
+			},
+			expected: []string{
+				"my_workflow", "23", "my_workflow2", "12", "old_workflow", "2022-06-01T09:30:00",
+			},
+		},
+		"single page": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:              http.StatusOK,
+					responseFile:            "list_page_1.json",
+					additionalResponseFiles: []string{"list_page_2.json"},
+				},
+			},
+			args:     []string{"--size", "2"},
+			expected: []string{"my_workflow", "my_workflow2"},
+			unwanted: []string{"old_workflow"},
+		},
+		"all pages from a given page size": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:              http.StatusOK,
+					responseFile:            "list_page_1.json",
+					additionalResponseFiles: []string{"list_page_2.json"},
+				},
+			},
+			args:     []string{"--size", "2", "--all-pages", "--json"},
+			expected: []string{`"name": "my_workflow2"`, `"name": "old_workflow"`},
+		},
+		"all pages sorted by another column": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:              http.StatusOK,
+					responseFile:            "list_page_1.json",
+					additionalResponseFiles: []string{"list_page_2.json"},
+				},
+			},
+			args:     []string{"--sort", "name"},
+			expected: []string{"old_workflow"},
+		},
+		"limit": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:              http.StatusOK,
+					responseFile:            "list_page_1.json",
+					additionalResponseFiles: []string{"list_page_2.json"},
+				},
+			},
+			args:     []string{"--limit", "1"},
+			expected: []string{"my_workflow", "23"},
+			unwanted: []string{"my_workflow2", "old_workflow"},
+		},
+		"invalid limit": {
+			args:      []string{"--limit", "-1"},
+			expected:  []string{"invalid value for '--limit': it must be a positive number"},
+			wantError: true,
+		},
+		"filter expression": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:     []string{"--filter", "created>=2022-08-01 and name~^my_"},
+			expected: []string{"my_workflow2"},
+			unwanted: []string{"2022-07-28"},
+		},
+		"invalid filter expression": {
+			args:      []string{"--filter", "created>yesterday"},
+			expected:  []string{"invalid filter 'created>yesterday': invalid value for 'created': invalid date 'yesterday'"},
+			wantError: true,
+		},
+		"custom columns": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args: []string{
+				"--columns", "name:Workflow,size_kib,duration_m=duration/60:Minutes",
+			},
+			expected: []string{"WORKFLOW", "SIZE_KIB", "MINUTES", "my_workflow2", "8.3"},
+			unwanted: []string{"RUN_NUMBER", "STATUS"},
+		},
+		"group by": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:              http.StatusOK,
+					responseFile:            "list_page_1.json",
+					additionalResponseFiles: []string{"list_page_2.json"},
+				},
+			},
+			args:     []string{"--group-by", "status", "--agg", "count,sum(size)", "--json"},
+			expected: []string{`"count": 2`, `"status": "finished"`, `"sum(size)": 3072`, `"status": "running"`},
+		},
+		"aggregates of all the rows": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:     []string{"--agg", "count,max(run_number)"},
+			expected: []string{"COUNT", "MAX(RUN_NUMBER)", "2", "23"},
+		},
+		"columns with format": {
+			args:      []string{"--columns", "name", "--format", "name"},
+			expected:  []string{"--columns, --group-by and --agg cannot be used together with --format"},
+			wantError: true,
+		},
+		"invalid aggregate": {
+			args:      []string{"--agg", "median(size)"},
+			expected:  []string{"invalid aggregation 'median(size)'"},
+			wantError: true,
+		},
 		"interactive sessions": {
 			serverResponses: map[string]ServerResponse{
 				listServerPath: {
@@ -52,12 +175,24 @@ func TestList(t *testing.T) {
 				"NAME", "RUN_NUMBER", "CREATED", "SESSION_TYPE", "SESSION_URI", "SESSION_STATUS",
 				"my_workflow", "23", "2022-07-28T12:04:37", "jupyter", "/session1uri", "created",
 				"my_workflow2", "12", "2022-08-10T17:14:12", "/session2uri",
+				"jupyter: Open the URL in your web browser to use the Jupyter notebook.",
 			},
 			unwanted: []string{
 				"ID", "USER", "SIZE", "PROGRESS", "DURATION",
 				"STARTED", "ENDED", " STATUS",
 			},
 		},
+		"interactive sessions json": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:     []string{"-s", "--json"},
+			expected: []string{"\"session_type\": \"jupyter\""},
+			unwanted: []string{"Open the URL in your web browser"},
+		},
 		"format columns": {
 			serverResponses: map[string]ServerResponse{
 				listServerPath: {
@@ -178,6 +313,22 @@ func TestList(t *testing.T) {
 			expected: []string{"SIZE", "1 KiB"},
 			unwanted: []string{"1024", " -1 "},
 		},
+		"human readable size in SI units": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:     []string{"--include-workspace-size", "-h", "--units", "si"},
+			expected: []string{"SIZE", "1.02 kB"},
+			unwanted: []string{"1024", "KiB"},
+		},
+		"invalid units": {
+			args:      []string{"--include-workspace-size", "-h", "--units", "metric"},
+			expected:  []string{"invalid value for 'units': 'metric' is not part of 'iec', 'si'"},
+			wantError: true,
+		},
 		"include duration": {
 			serverResponses: map[string]ServerResponse{
 				listServerPath: {
@@ -216,6 +367,42 @@ func TestList(t *testing.T) {
 			args:     []string{"--sort", "run_number"},
 			expected: []string{"STATUS  \nmy_workflow "},
 		},
+		"sorted descending": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:     []string{"--sort", "-run_number"},
+			expected: []string{"STATUS  \nmy_workflow "},
+		},
+		"sorted ascending": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:     []string{"--sort", "+run_number"},
+			expected: []string{"STATUS  \nmy_workflow2 "},
+		},
+		"sorted by several columns": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:     []string{"--sort", "status,-created"},
+			expected: []string{"STATUS  \nmy_workflow "},
+			unwanted: []string{"Warning"},
+		},
+		"invalid sort key": {
+			args:      []string{"--sort", "status,-"},
+			expected:  []string{"invalid sort column '-'"},
+			wantError: true,
+		},
 		"malformed filters": {
 			args: []string{"--filter", "name"},
 			expected: []string{
@@ -302,6 +489,19 @@ func TestList(t *testing.T) {
 				"SHARED_WITH",
 			},
 		},
+		"shared by not supported by old server": {
+			serverResponses: map[string]ServerResponse{
+				pingServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "ping.json",
+				},
+			},
+			args: []string{"--shared-by", "anybody"},
+			expected: []string{
+				"--shared-by is not supported by server 0.9, it requires REANA 0.95 or newer",
+			},
+			wantError: true,
+		},
 		"invalid: shared with and shared by in the same command": {
 			args: []string{
 				"--shared-by",
@@ -479,7 +679,7 @@ func TestParseListFilters(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			statusFilters, searchFilter, err := parseListFilters(
+			statusFilters, searchFilter, _, err := parseListFilters(
 				test.filterInput, test.showDeletedRuns, test.showAll,
 			)
 			if test.wantError {
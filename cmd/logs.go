@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2024, 2025 CERN.
+Copyright (C) 2022, 2024, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -10,13 +10,18 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"reanahub/reana-client-go/client"
 	"reanahub/reana-client-go/client/operations"
 	"reanahub/reana-client-go/pkg/config"
 	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/fileutils"
 	"reanahub/reana-client-go/pkg/filterer"
+	"reanahub/reana-client-go/pkg/logsearch"
+	"reanahub/reana-client-go/pkg/logstream"
+	"reanahub/reana-client-go/pkg/paginator"
 	"sort"
 	"strings"
 	"time"
@@ -32,6 +37,14 @@ Get workflow logs.
 
 The ` + "``logs``" + ` command allows to retrieve logs of a running workflow.
 
+With ` + "``--follow``" + `, the logs of the workflow engine are followed, or, if steps are
+given with ` + "``--filter step=...``" + `, the logs of all the jobs of these steps, each line
+being prefixed by the name of its step. Jobs that start while following are picked
+up automatically, and the polling interval grows while no new logs are emitted.
+
+With ` + "``--all-pages``" + ` or ` + "``--limit``" + `, the job logs are retrieved page by page,
+starting from ` + "``--page``" + `, and merged together.
+
 Examples:
 
 $ reana-client logs -w myanalysis.42
@@ -41,12 +54,28 @@ $ reana-client logs -w myanalysis.42 --json
 $ reana-client logs -w myanalysis.42 --filter status=running
 
 $ reana-client logs -w myanalysis.42 --filter step=myfit --follow
+
+$ reana-client logs -w myanalysis.42 --filter step=myfit --filter step=myplot --follow
+
+$ reana-client logs -w myanalysis.42 --size 10 --all-pages
+
+$ reana-client logs -w myanalysis.42 --filter status=failed --limit 1
+
+$ reana-client logs -w myanalysis.42 --filter "status!=finished and started_at>=2026-01-01"
+
+$ reana-client logs -w myanalysis.42 --grep "Error in <TFile" -C 2
+
+$ reana-client logs -w myanalysis.42 --export ./logs --archive logs.tar.gz
 `
 
 const logsFilterFlagDesc = `Filter job logs to include only those steps that
 match certain filtering criteria. Use --filter
 name=value pairs. Available filters are
-compute_backend, docker_img, status and step.`
+compute_backend, docker_img, status and step.
+Filter expressions such as 'step~^fit and
+status!=finished' can also use the operators
+'!=', '>', '>=', '<', '<=', '~' and '!~', as well
+as started_at and finished_at.`
 
 // logsFollowMinInterval is the minimum interval between log polling.
 const logsFollowMinInterval = 1
@@ -54,6 +83,22 @@ const logsFollowMinInterval = 1
 // logsFollowDefautlInterval is the default interval between log polling.
 const logsFollowDefautlInterval = 10
 
+// logsFollowMaxIntervalFactor is the maximum factor by which the polling interval grows
+// while the followed logs do not change.
+const logsFollowMaxIntervalFactor = 6
+
+// logsFollowColors are the colors of the prefixes of the followed jobs, used in turn.
+var logsFollowColors = []text.Color{
+	text.FgCyan,
+	text.FgMagenta,
+	text.FgBlue,
+	text.FgGreen,
+	text.FgYellow,
+	text.FgHiCyan,
+	text.FgHiMagenta,
+	text.FgHiBlue,
+}
+
 // logs struct that contains the logs of a workflow.
 // Pointers used for nullable values
 type logs struct {
@@ -93,6 +138,18 @@ type logsOptions struct {
 	size       int64
 	follow     bool
 	interval   int64
+	grep       string
+	context    int
+	ignoreCase bool
+	exportDir  string
+	archive    string
+	paginationOptions
+}
+
+// jobLogEntry struct that contains the log of a job together with its identifier.
+type jobLogEntry struct {
+	id   string
+	item jobLogItem
 }
 
 // logsCommandRunner struct that executes logs command.
@@ -149,6 +206,7 @@ func newLogsCmd() *cobra.Command {
 		0,
 		"Size of results per page (to be used with --page).",
 	)
+	addPaginationFlags(f, &o.paginationOptions)
 	f.BoolVar(
 		&o.follow,
 		"follow",
@@ -165,6 +223,47 @@ func newLogsCmd() *cobra.Command {
 			logsFollowDefautlInterval,
 		),
 	)
+	f.StringVar(
+		&o.grep,
+		"grep",
+		"",
+		`Only show the log lines matching the given regular
+expression, together with the step they belong to.`,
+	)
+	f.IntVarP(
+		&o.context,
+		"context",
+		"C",
+		0,
+		"Number of lines to show before and after each matching line (to be used with --grep).",
+	)
+	f.BoolVar(
+		&o.ignoreCase,
+		"ignore-case",
+		false,
+		"Ignore the case of letters when matching the regular expression (to be used with --grep).",
+	)
+	f.StringVar(
+		&o.exportDir,
+		"export",
+		"",
+		`Export the logs to the given directory, in one file
+per log, together with the metadata of the jobs.`,
+	)
+	f.StringVar(
+		&o.archive,
+		"archive",
+		"",
+		"Export the logs to the given tar.gz archive, e.g. logs.tar.gz.",
+	)
+
+	registerFlagCompletion(cmd, "filter", completeFilters(
+		append(slices.Clone(config.LogsSingleFilters), config.LogsMultiFilters...),
+		map[string][]string{
+			"status":          config.GetRunStatuses(true),
+			"compute_backend": config.ReanaComputeBackendKeys,
+		},
+	))
 
 	return cmd
 }
@@ -180,6 +279,16 @@ func newLogsCommandRunner(
 // run executes the logs command.
 func (r *logsCommandRunner) run(cmd *cobra.Command) error {
 	r.validateOptions(cmd.OutOrStdout())
+	if r.options.grep != "" && r.options.follow {
+		return errors.New("please provide either --grep or --follow, not both")
+	}
+	exporting := r.options.exportDir != "" || r.options.archive != ""
+	if exporting && (r.options.follow || r.options.grep != "") {
+		return errors.New("--export and --archive cannot be used together with --follow or --grep")
+	}
+	if r.options.context < 0 {
+		return errors.New("invalid value for '--context': it must be a positive number")
+	}
 
 	filters, err := parseLogsFilters(r.options.filters)
 	if err != nil {
@@ -199,102 +308,186 @@ func (r *logsCommandRunner) run(cmd *cobra.Command) error {
 		logsParams.SetSize(&r.options.size)
 	}
 
+	if r.options.follow && filters.HasExpressions() {
+		return errors.New("filter expressions cannot be used together with --follow")
+	}
+	paging := r.options.allPages || r.options.limit != 0
+	if paging && r.options.follow {
+		return errors.New("--all-pages and --limit cannot be used together with --follow")
+	}
+
 	if r.options.follow {
+		api, err := client.ApiClient()
+		if err != nil {
+			return err
+		}
+		if err := requireServerFeature(api, r.options.token, "live-logs", "--follow"); err != nil {
+			return err
+		}
 		return r.followLogs(logsParams, cmd, steps)
 	}
 
+	if paging {
+		options, err := r.options.paginatorOptions(
+			cmd,
+			r.options.page,
+			r.options.size,
+			config.JobLogsPageSize,
+		)
+		if err != nil {
+			return err
+		}
+		workflowLogs, err := r.getAllLogs(logsParams, filters, options)
+		if err != nil {
+			return err
+		}
+		return r.displayLogs(workflowLogs, cmd, steps)
+	}
+
 	return r.retrieveLogs(filters, logsParams, cmd, steps)
 }
 
-// followLogs follows the logs of a running workflow or job.
+// followLogs follows the logs of a running workflow, or of the jobs of the given steps.
+// When following steps, the logs of each job are prefixed by its step name, and the jobs
+// that start while following are picked up automatically. The polling interval grows
+// while no new logs are emitted, up to logsFollowMaxIntervalFactor times the given interval.
 func (r *logsCommandRunner) followLogs(
 	logsParams *operations.GetWorkflowLogsParams,
 	cmd *cobra.Command,
 	steps []string,
 ) error {
-	stepLength := len(steps)
-	var step, previousLogs string
 	stdout := cmd.OutOrStdout()
 
-	if stepLength > 0 {
-		step = steps[0]
-	}
-
-	if stepLength > 1 {
-		displayer.DisplayMessage(
-			"Only one step can be followed at a time, ignoring additional steps.",
-			displayer.Warning,
-			false,
-			stdout,
-		)
-		logsParams.SetSteps([]string{step})
-	}
-
 	workflowStatusParams := operations.NewGetWorkflowStatusParams()
 	workflowStatusParams.SetAccessToken(&r.options.token)
 	workflowStatusParams.SetWorkflowIDOrName(r.options.workflow)
 
+	baseInterval := time.Duration(r.options.interval) * time.Second
+	interval := logstream.NewInterval(
+		baseInterval,
+		baseInterval*logsFollowMaxIntervalFactor,
+	)
+	workflowStream := &followedLog{name: "workflow logs"}
+	jobStreams := make(map[string]*followedLog)
+	var followed []*followedLog
+	waitingAnnounced := false
+
 	for {
-		newLogs, status, err := r.getLogsWithStatus(
-			step,
-			logsParams,
-			workflowStatusParams,
-		)
+		workflowLogs, err := r.getLogs(logsParams)
 		if err != nil {
 			return err
 		}
 
-		fmt.Fprint(stdout, strings.TrimPrefix(newLogs, previousLogs))
+		activity := false
+		if len(steps) == 0 {
+			if workflowLogs.WorkflowLogs != nil {
+				activity = followLog(stdout, workflowStream, *workflowLogs.WorkflowLogs)
+			}
+			workflowCompleted, err := r.isWorkflowCompleted(workflowStatusParams)
+			if err != nil {
+				return err
+			}
+			if workflowCompleted {
+				// Retrieve the logs once more, in case the workflow completed after they were retrieved.
+				workflowLogs, err = r.getLogs(logsParams)
+				if err != nil {
+					return err
+				}
+				if workflowLogs.WorkflowLogs != nil {
+					followLog(stdout, workflowStream, *workflowLogs.WorkflowLogs)
+				}
+				flushFollowedLogs(stdout, []*followedLog{workflowStream})
+				displayFollowCompleted(stdout, "Workflow has")
+				return nil
+			}
+			time.Sleep(interval.Next(activity))
+			continue
+		}
 
-		if slices.Contains(config.WorkflowCompletedStatuses, status) {
-			subject := "Workflow"
-			if stepLength > 0 {
-				subject = "Job"
+		jobsCompleted := true
+		for _, source := range jobLogSources(workflowLogs.JobLogs) {
+			if !slices.Contains(steps, source.step) {
+				continue
 			}
-			displayer.DisplayMessage(
-				fmt.Sprintf(
-					"%s has completed, you might want to rerun the command without the --follow flag.",
-					subject,
-				),
-				displayer.Info,
-				false,
-				stdout,
-			)
+			stream, exists := jobStreams[source.jobID]
+			if !exists {
+				stream = &followedLog{
+					name:   fmt.Sprintf("logs of step %s (job %s)", source.step, source.jobID),
+					prefix: followPrefix(source, workflowLogs.JobLogs),
+					colors: text.Colors{
+						logsFollowColors[len(followed)%len(logsFollowColors)],
+					},
+				}
+				jobStreams[source.jobID] = stream
+				followed = append(followed, stream)
+				displayer.DisplayMessage(
+					fmt.Sprintf("Following step %s (job %s).", source.step, source.jobID),
+					displayer.Info,
+					false,
+					stdout,
+				)
+				activity = true
+			}
+			if followLog(stdout, stream, source.content) {
+				activity = true
+			}
+			if !slices.Contains(config.WorkflowCompletedStatuses, source.status) {
+				jobsCompleted = false
+			}
+		}
+
+		// The job statuses are retrieved together with their logs, so no logs can be missed here.
+		missingSteps := findMissingSteps(workflowLogs.JobLogs, steps)
+		if len(missingSteps) == 0 && jobsCompleted {
+			flushFollowedLogs(stdout, followed)
+			subject := "Job has"
+			if len(followed) > 1 {
+				subject = "Jobs have"
+			}
+			displayFollowCompleted(stdout, subject)
 			return nil
 		}
+		if len(missingSteps) > 0 {
+			workflowCompleted, err := r.isWorkflowCompleted(workflowStatusParams)
+			if err != nil {
+				return err
+			}
+			if workflowCompleted {
+				flushFollowedLogs(stdout, followed)
+				return fmt.Errorf("step %s not found", strings.Join(missingSteps, ", "))
+			}
+			if !waitingAnnounced {
+				displayer.DisplayMessage(
+					fmt.Sprintf(
+						"Waiting for step(s) %s to start...",
+						strings.Join(missingSteps, ", "),
+					),
+					displayer.Info,
+					false,
+					stdout,
+				)
+				waitingAnnounced = true
+			}
+		}
 
-		time.Sleep(time.Duration(r.options.interval) * time.Second)
-		previousLogs = newLogs
+		time.Sleep(interval.Next(activity))
 	}
 }
 
-// getData retrieves logs and status of a workflow or a job.
-func (r *logsCommandRunner) getLogsWithStatus(
-	step string,
-	logsParams *operations.GetWorkflowLogsParams,
+// isWorkflowCompleted retrieves the status of the followed workflow and returns whether it has completed.
+func (r *logsCommandRunner) isWorkflowCompleted(
 	workflowStatusParams *operations.GetWorkflowStatusParams,
-) (string, string, error) {
-	workflowLogs, err := r.getLogs(logsParams)
-	if err != nil {
-		return "", "", err
-	}
-
-	if step != "" {
-		job := findJobByStep(workflowLogs.JobLogs, step)
-		if job == nil {
-			return "", "", fmt.Errorf("step %s not found", step)
-		}
-		return job.Logs, job.Status, nil
-	}
-
+) (bool, error) {
 	statusResponse, err := r.api.Operations.GetWorkflowStatus(
 		workflowStatusParams,
 	)
 	if err != nil {
-		return "", "", err
+		return false, err
 	}
-
-	return *workflowLogs.WorkflowLogs, statusResponse.GetPayload().Status, nil
+	return slices.Contains(
+		config.WorkflowCompletedStatuses,
+		statusResponse.GetPayload().Status,
+	), nil
 }
 
 // getLogs retrieves logs of a workflow and unmarshals data into logs structure.
@@ -319,6 +512,80 @@ func (r *logsCommandRunner) getLogs(
 	return workflowLogs, nil
 }
 
+// getAllLogs retrieves the logs of a workflow page by page, merging the job logs of all the pages.
+// The workflow, engine and service logs are those of the first page. The limit applies to the
+// job logs matching the filters, which are sorted by start date in each page.
+func (r *logsCommandRunner) getAllLogs(
+	logsParams *operations.GetWorkflowLogsParams,
+	filters filterer.Filters,
+	options paginator.Options,
+) (logs, error) {
+	var workflowLogs logs
+	first := true
+	seen := map[string]bool{}
+	pages := paginator.New(options, func(page, size int64) ([]jobLogEntry, int64, error) {
+		params := *logsParams
+		params.SetPage(&page)
+		if size > 0 {
+			params.SetSize(&size)
+		}
+		pageLogs, err := r.getLogs(&params)
+		if err != nil {
+			return nil, 0, err
+		}
+		if first {
+			workflowLogs = pageLogs
+			workflowLogs.JobLogs = map[string]jobLogItem{}
+			first = false
+		}
+
+		// Servers ignoring the page number return the same jobs again, which ends the pagination.
+		var entries []jobLogEntry
+		for id, item := range pageLogs.JobLogs {
+			if !seen[id] {
+				seen[id] = true
+				entries = append(entries, jobLogEntry{id: id, item: item})
+			}
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			if startedAt(entries[i].item) != startedAt(entries[j].item) {
+				return startedAt(entries[i].item) < startedAt(entries[j].item)
+			}
+			return entries[i].id < entries[j].id
+		})
+		return entries, 0, nil
+	})
+
+	var filterErr error
+	pages.Filter(func(entry jobLogEntry) bool {
+		jobLogs := map[string]jobLogItem{entry.id: entry.item}
+		if err := filterJobLogs(&jobLogs, filters); err != nil {
+			filterErr = err
+		}
+		return len(jobLogs) == 1
+	})
+
+	entries, err := pages.All()
+	if err != nil {
+		return workflowLogs, err
+	}
+	if filterErr != nil {
+		return workflowLogs, filterErr
+	}
+	for _, entry := range entries {
+		workflowLogs.JobLogs[entry.id] = entry.item
+	}
+	return workflowLogs, nil
+}
+
+// startedAt returns the start date of a job, or an empty string if it has not started yet.
+func startedAt(item jobLogItem) string {
+	if item.StartedAt == nil {
+		return ""
+	}
+	return *item.StartedAt
+}
+
 // validateOptions validates the options of the logs command.
 func (r *logsCommandRunner) validateOptions(writer io.Writer) {
 	if r.options.jsonOutput && r.options.follow {
@@ -360,6 +627,17 @@ func (r *logsCommandRunner) retrieveLogs(
 	if err != nil {
 		return err
 	}
+	return r.displayLogs(workflowLogs, cmd, steps)
+}
+
+// displayLogs prints, searches or exports the logs of a workflow.
+func (r *logsCommandRunner) displayLogs(workflowLogs logs, cmd *cobra.Command, steps []string) error {
+	if r.options.grep != "" {
+		return r.grepLogs(cmd, workflowLogs)
+	}
+	if r.options.exportDir != "" || r.options.archive != "" {
+		return r.exportLogs(cmd, workflowLogs)
+	}
 
 	if r.options.jsonOutput {
 		err := displayer.DisplayJsonOutput(workflowLogs, cmd.OutOrStdout())
@@ -372,6 +650,76 @@ func (r *logsCommandRunner) retrieveLogs(
 	return nil
 }
 
+// grepLogs displays the lines of the logs matching the --grep regular expression.
+func (r *logsCommandRunner) grepLogs(cmd *cobra.Command, workflowLogs logs) error {
+	re, err := logsearch.Compile(r.options.grep, r.options.ignoreCase)
+	if err != nil {
+		return err
+	}
+	matches := grepLogSources(allLogSources(workflowLogs), re, r.options.context)
+
+	out := cmd.OutOrStdout()
+	if r.options.jsonOutput {
+		if matches == nil {
+			matches = []logMatch{}
+		}
+		return displayer.DisplayJsonOutput(matches, out)
+	}
+	if len(matches) == 0 {
+		displayer.DisplayMessage(
+			fmt.Sprintf("No log lines match '%s'.", r.options.grep),
+			displayer.Info,
+			false,
+			out,
+		)
+		return nil
+	}
+	displayLogMatches(matches, re, false, out)
+	return nil
+}
+
+// exportLogs writes the logs to the --export directory and the --archive file.
+func (r *logsCommandRunner) exportLogs(cmd *cobra.Command, workflowLogs logs) error {
+	files, err := buildLogsExport(r.options.workflow, workflowLogs)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if r.options.exportDir != "" {
+		if err := fileutils.WriteFiles(r.options.exportDir, files); err != nil {
+			return err
+		}
+		displayer.DisplayMessage(
+			fmt.Sprintf(
+				"Logs of workflow %s exported to %s",
+				r.options.workflow,
+				r.options.exportDir,
+			),
+			displayer.Success,
+			false,
+			out,
+		)
+	}
+	if r.options.archive != "" {
+		root := fileutils.SanitizeFileName(r.options.workflow) + "-logs"
+		if err := fileutils.WriteTarGz(r.options.archive, root, files); err != nil {
+			return err
+		}
+		displayer.DisplayMessage(
+			fmt.Sprintf(
+				"Logs of workflow %s archived in %s",
+				r.options.workflow,
+				r.options.archive,
+			),
+			displayer.Success,
+			false,
+			out,
+		)
+	}
+	return nil
+}
+
 // findJobByStep returns the job whose JobName matches step, or nil
 // if no entry matches. When several jobs share the same step name
 // (scatter and parallel steps in yadage/cwl, snakemake fan-outs,
@@ -397,9 +745,10 @@ func findJobByStep(items map[string]jobLogItem, step string) *jobLogItem {
 // parseLogsFilters parses a list of filters in the format 'filter=value', for the 'logs' command.
 // Returns an error if any of the given filters are not valid.
 func parseLogsFilters(filterInput []string) (filterer.Filters, error) {
-	filters, err := filterer.NewFilters(
+	filters, err := filterer.NewTypedFilters(
 		config.LogsSingleFilters,
 		config.LogsMultiFilters,
+		logsFilterTypes,
 		filterInput,
 	)
 	if err != nil {
@@ -422,6 +771,12 @@ func parseLogsFilters(filterInput []string) (filterer.Filters, error) {
 	return filters, nil
 }
 
+// logsFilterTypes types of the job fields compared in filter expressions.
+var logsFilterTypes = map[string]filterer.ValueType{
+	"started_at":  filterer.DateValue,
+	"finished_at": filterer.DateValue,
+}
+
 // filterJobLogs returns a subset of jobLogs based on the given filters.
 func filterJobLogs(
 	jobLogs *map[string]jobLogItem,
@@ -440,6 +795,10 @@ func filterJobLogs(
 
 	var unwantedLogs []string
 	for jobLogKey, jobLogValue := range jobLogsMap {
+		if !filters.Match(jobFilterValues(jobLogValue)) {
+			unwantedLogs = append(unwantedLogs, jobLogKey)
+			continue
+		}
 		for _, filterKey := range filters.SingleFilterKeys {
 			filterValue, _ := filters.GetSingle(filterKey)
 			if filterKey == "compute_backend" {
@@ -458,6 +817,20 @@ func filterJobLogs(
 	return nil
 }
 
+// jobFilterValues returns the values of the job fields compared in filter expressions, where the
+// step is the name of the job and the compute backend is referenced as in the command line.
+func jobFilterValues(jobLog map[string]string) map[string]string {
+	values := map[string]string{}
+	for key, value := range jobLog {
+		if value != "" {
+			values[key] = value
+		}
+	}
+	values["step"] = jobLog["job_name"]
+	values["compute_backend"] = strings.ToLower(jobLog["compute_backend"])
+	return values
+}
+
 // displayHumanFriendlyLogs displays the logs in a human friendly way.
 func displayHumanFriendlyLogs(cmd *cobra.Command, logs logs, steps []string) {
 	if logs.WorkflowLogs != nil && *logs.WorkflowLogs != "" {
@@ -617,3 +990,88 @@ func displayLogHeader(cmd *cobra.Command, title string) {
 		text.FgYellow,
 	)
 }
+
+// followedLog is a log being followed, with the prefix and the colors of its lines.
+type followedLog struct {
+	logstream.Stream
+	name   string
+	prefix string
+	colors text.Colors
+}
+
+// followLog prints the new lines of the followed log, given its whole content.
+// Returns true if any new line was printed.
+func followLog(out io.Writer, stream *followedLog, content string) bool {
+	lines, reset := stream.Update(content)
+	if reset {
+		displayer.DisplayMessage(
+			fmt.Sprintf(
+				"The %s were truncated or rotated, showing them from the start.",
+				stream.name,
+			),
+			displayer.Warning,
+			false,
+			out,
+		)
+	}
+	for _, line := range lines {
+		printFollowedLine(out, stream, line)
+	}
+	return len(lines) > 0
+}
+
+// flushFollowedLogs prints the last line of the followed logs that was not terminated by a newline.
+func flushFollowedLogs(out io.Writer, streams []*followedLog) {
+	for _, stream := range streams {
+		if line := stream.Flush(); line != "" {
+			printFollowedLine(out, stream, line)
+		}
+	}
+}
+
+// printFollowedLine prints a line of a followed log, after its colored prefix if any.
+func printFollowedLine(out io.Writer, stream *followedLog, line string) {
+	if stream.prefix != "" {
+		displayer.PrintColorable(fmt.Sprintf("[%s] ", stream.prefix), out, stream.colors...)
+	}
+	fmt.Fprintln(out, line)
+}
+
+// followPrefix returns the prefix of the lines of a followed job: its step name, followed by
+// its job ID if several jobs of the step exist, e.g. for scattered steps.
+func followPrefix(source logSource, jobLogs map[string]jobLogItem) string {
+	jobs := 0
+	for _, job := range jobLogs {
+		if job.JobName == source.step {
+			jobs++
+		}
+	}
+	if jobs > 1 {
+		return fmt.Sprintf("%s:%s", source.step, source.jobID)
+	}
+	return source.step
+}
+
+// findMissingSteps returns the steps that do not have any job yet.
+func findMissingSteps(jobLogs map[string]jobLogItem, steps []string) []string {
+	var missingSteps []string
+	for _, step := range steps {
+		if findJobByStep(jobLogs, step) == nil {
+			missingSteps = append(missingSteps, step)
+		}
+	}
+	return missingSteps
+}
+
+// displayFollowCompleted displays that the followed workflow or jobs have completed.
+func displayFollowCompleted(out io.Writer, subject string) {
+	displayer.DisplayMessage(
+		fmt.Sprintf(
+			"%s completed, you might want to rerun the command without the --follow flag.",
+			subject,
+		),
+		displayer.Info,
+		false,
+		out,
+	)
+}
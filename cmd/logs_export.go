@@ -0,0 +1,127 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/fileutils"
+	"strings"
+	"time"
+)
+
+// logsExportMetadata describes the exported logs of a workflow, in metadata.json.
+type logsExportMetadata struct {
+	Workflow   string                     `json:"workflow"`
+	ExportedAt string                     `json:"exported_at"`
+	Files      []string                   `json:"files"`
+	Jobs       []jobLogExportMetadata     `json:"jobs"`
+	Services   []serviceLogExportMetadata `json:"services"`
+}
+
+// jobLogExportMetadata contains the information of an exported job, without its logs.
+type jobLogExportMetadata struct {
+	JobID          string  `json:"job_id"`
+	JobName        string  `json:"job_name"`
+	WorkflowUuid   string  `json:"workflow_uuid"`
+	ComputeBackend string  `json:"compute_backend"`
+	BackendJobId   string  `json:"backend_job_id"`
+	DockerImg      string  `json:"docker_img"`
+	Cmd            string  `json:"cmd"`
+	Status         string  `json:"status"`
+	StartedAt      *string `json:"started_at"`
+	FinishedAt     *string `json:"finished_at"`
+	LogFile        string  `json:"log_file"`
+}
+
+// serviceLogExportMetadata contains the information of an exported service, without its logs.
+type serviceLogExportMetadata struct {
+	Name       string   `json:"name"`
+	Components []string `json:"components"`
+	LogFile    string   `json:"log_file"`
+}
+
+// buildLogsExport returns the files of the logs export of a workflow, indexed by their path.
+// The workflow engine logs are written in workflow.log, the engine internal logs in engine.log,
+// the logs of each service in service/<name>.log and the logs of each job in jobs/<step>-<id>.log.
+// The information of the jobs and services is written in metadata.json.
+func buildLogsExport(workflow string, workflowLogs logs) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	metadata := logsExportMetadata{
+		Workflow:   workflow,
+		ExportedAt: time.Now().UTC().Format("2006-01-02T15:04:05"),
+		Files:      []string{},
+		Jobs:       []jobLogExportMetadata{},
+		Services:   []serviceLogExportMetadata{},
+	}
+	addFile := func(name, content string) {
+		files[name] = []byte(content)
+		metadata.Files = append(metadata.Files, name)
+	}
+
+	if workflowLogs.WorkflowLogs != nil && *workflowLogs.WorkflowLogs != "" {
+		addFile("workflow.log", *workflowLogs.WorkflowLogs)
+	}
+	if workflowLogs.EngineSpecific != nil && *workflowLogs.EngineSpecific != "" {
+		addFile("engine.log", *workflowLogs.EngineSpecific)
+	}
+
+	for _, serviceName := range sortedServiceNames(workflowLogs.ServiceLogs) {
+		entries := workflowLogs.ServiceLogs[serviceName]
+		if len(entries) == 0 {
+			continue
+		}
+		service := serviceLogExportMetadata{
+			Name:    serviceName,
+			LogFile: fmt.Sprintf("service/%s.log", fileutils.SanitizeFileName(serviceName)),
+		}
+		var content strings.Builder
+		for _, entry := range entries {
+			service.Components = append(service.Components, entry.Component)
+			fmt.Fprintf(&content, "%s Component: %s\n", config.LeadingMark, entry.Component)
+			content.WriteString(entry.Content)
+			if entry.Content != "" && !strings.HasSuffix(entry.Content, "\n") {
+				content.WriteString("\n")
+			}
+		}
+		addFile(service.LogFile, content.String())
+		metadata.Services = append(metadata.Services, service)
+	}
+
+	for _, source := range jobLogSources(workflowLogs.JobLogs) {
+		job := workflowLogs.JobLogs[source.jobID]
+		logFile := fmt.Sprintf(
+			"jobs/%s-%s.log",
+			fileutils.SanitizeFileName(source.step),
+			fileutils.SanitizeFileName(source.jobID),
+		)
+		addFile(logFile, job.Logs)
+		metadata.Jobs = append(metadata.Jobs, jobLogExportMetadata{
+			JobID:          source.jobID,
+			JobName:        job.JobName,
+			WorkflowUuid:   job.WorkflowUuid,
+			ComputeBackend: job.ComputeBackend,
+			BackendJobId:   job.BackendJobId,
+			DockerImg:      job.DockerImg,
+			Cmd:            job.Cmd,
+			Status:         job.Status,
+			StartedAt:      job.StartedAt,
+			FinishedAt:     job.FinishedAt,
+			LogFile:        logFile,
+		})
+	}
+
+	metadataJSON, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	files["metadata.json"] = append(metadataJSON, '\n')
+	return files, nil
+}
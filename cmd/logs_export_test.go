@@ -0,0 +1,135 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLogsExport(t *testing.T) {
+	workflowName := "my_workflow"
+	logsResponse := map[string]ServerResponse{
+		fmt.Sprintf(logsPathTemplate, workflowName): {
+			statusCode:   http.StatusOK,
+			responseFile: "logs_complete.json",
+		},
+	}
+
+	t.Run("export directory", func(t *testing.T) {
+		exportDir := filepath.Join(t.TempDir(), "logs")
+		testCmdRun(t, TestCmdParams{
+			cmd:             "logs",
+			serverResponses: logsResponse,
+			args:            []string{"-w", workflowName, "--export", exportDir},
+			expected:        []string{"Logs of workflow my_workflow exported to " + exportDir},
+			unwanted:        []string{"Workflow engine logs"},
+		})
+
+		wantFiles := map[string]string{
+			"workflow.log":    "workflow logs",
+			"engine.log":      "engine logs",
+			"jobs/job1-1.log": "workflow 1 logs",
+			"jobs/job2-2.log": "workflow 2 logs",
+			"service/dask-service-12345abc.log": "==> Component: scheduler\nscheduler dask logs\n" +
+				"==> Component: worker-1b35478060\nworker dask logs\n",
+		}
+		for name, want := range wantFiles {
+			got, err := os.ReadFile(filepath.Join(exportDir, filepath.FromSlash(name)))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if string(got) != want {
+				t.Errorf("Expected %s to contain %q, got %q", name, want, got)
+			}
+		}
+
+		data, err := os.ReadFile(filepath.Join(exportDir, "metadata.json"))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		var metadata logsExportMetadata
+		if err := json.Unmarshal(data, &metadata); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if metadata.Workflow != workflowName || len(metadata.Jobs) != 2 {
+			t.Fatalf("Unexpected metadata: %+v", metadata)
+		}
+		job := metadata.Jobs[0]
+		if job.DockerImg != "docker1" || job.Cmd != "ls" ||
+			job.ComputeBackend != "Kubernetes" || job.LogFile != "jobs/job1-1.log" ||
+			job.StartedAt == nil || *job.StartedAt != "2022-07-20T12:09:09" {
+			t.Errorf("Unexpected job metadata: %+v", job)
+		}
+		wantServices := []serviceLogExportMetadata{{
+			Name:       "dask-service-12345abc",
+			Components: []string{"scheduler", "worker-1b35478060"},
+			LogFile:    "service/dask-service-12345abc.log",
+		}}
+		if !reflect.DeepEqual(metadata.Services, wantServices) {
+			t.Errorf("Expected services %+v, got %+v", wantServices, metadata.Services)
+		}
+	})
+
+	t.Run("archive", func(t *testing.T) {
+		archive := filepath.Join(t.TempDir(), "logs.tar.gz")
+		testCmdRun(t, TestCmdParams{
+			cmd:             "logs",
+			serverResponses: logsResponse,
+			args:            []string{"-w", workflowName, "--archive", archive},
+			expected:        []string{"Logs of workflow my_workflow archived in " + archive},
+		})
+
+		file, err := os.Open(archive)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		defer file.Close()
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		tarReader := tar.NewReader(gzipReader)
+		var names []string
+		for {
+			header, err := tarReader.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			names = append(names, header.Name)
+		}
+		want := "my_workflow-logs/engine.log my_workflow-logs/jobs/job1-1.log " +
+			"my_workflow-logs/jobs/job2-2.log my_workflow-logs/metadata.json " +
+			"my_workflow-logs/service/dask-service-12345abc.log my_workflow-logs/workflow.log"
+		if got := strings.Join(names, " "); got != want {
+			t.Errorf("Expected archive entries %s, got %s", want, got)
+		}
+	})
+
+	t.Run("export and follow", func(t *testing.T) {
+		testCmdRun(t, TestCmdParams{
+			cmd:       "logs",
+			args:      []string{"-w", workflowName, "--export", t.TempDir(), "--follow"},
+			expected:  []string{"--export and --archive cannot be used together with --follow or --grep"},
+			wantError: true,
+		})
+	})
+}
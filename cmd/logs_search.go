@@ -0,0 +1,445 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/datautils"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/errorhandler"
+	"reanahub/reana-client-go/pkg/logsearch"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const logsSearchDesc = `
+Search the job logs of several workflows.
+
+The ` + "``logs-search``" + ` command looks for the given regular expression in the
+job logs of all the workflows matching the filtering criteria, and displays the
+matching lines together with the workflow and the step that produced them. Use
+` + "``--since``" + ` and ` + "``--until``" + ` to restrict the search to the workflows
+created in a given time range, which accept dates (e.g. 2026-01-01) or durations
+(e.g. 7d).
+
+Examples:
+
+  $ reana-client logs-search "Error in <TFile::TFile>"
+
+  $ reana-client logs-search --filter status=failed --since 7d "segmentation violation"
+
+  $ reana-client logs-search --filter name=myanalysis -C 3 --ignore-case "out of memory"
+`
+
+const logsSearchFilterFlagDesc = `Search only the workflows that match certain
+filtering criteria. Use --filter
+<columm_name>=<column_value> pairs. Available
+filters are 'name' and 'status'.`
+
+// logMatch is a group of consecutive log lines matching a pattern, together with the log they come from.
+type logMatch struct {
+	Workflow string           `json:"workflow,omitempty"`
+	Source   string           `json:"source"`
+	Step     string           `json:"step,omitempty"`
+	JobID    string           `json:"job_id,omitempty"`
+	Status   string           `json:"status,omitempty"`
+	Lines    []logsearch.Line `json:"lines"`
+}
+
+// logSource is a single log of a workflow, e.g. the engine logs or the logs of a job.
+type logSource struct {
+	name    string
+	step    string
+	jobID   string
+	status  string
+	content string
+}
+
+type logsSearchOptions struct {
+	token      string
+	filters    []string
+	since      string
+	until      string
+	context    int
+	ignoreCase bool
+	jsonOutput bool
+}
+
+// newLogsSearchCmd creates a command to search the job logs of several workflows.
+func newLogsSearchCmd() *cobra.Command {
+	o := &logsSearchOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "logs-search REGEX",
+		Short: "Search the job logs of several workflows.",
+		Long:  logsSearchDesc,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.run(cmd, args[0])
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(
+		&o.token,
+		"access-token",
+		"t",
+		"",
+		"Access token of the current user.",
+	)
+	f.StringSliceVar(&o.filters, "filter", []string{}, logsSearchFilterFlagDesc)
+	f.StringVar(
+		&o.since,
+		"since",
+		"",
+		`Only search the workflows created at or after the given
+date (e.g. 2026-01-01) or duration ago (e.g. 7d).`,
+	)
+	f.StringVar(
+		&o.until,
+		"until",
+		"",
+		`Only search the workflows created before the given
+date (e.g. 2026-02-01) or duration ago (e.g. 1d).`,
+	)
+	f.IntVarP(
+		&o.context,
+		"context",
+		"C",
+		0,
+		"Number of lines to show before and after each matching line.",
+	)
+	f.BoolVar(
+		&o.ignoreCase,
+		"ignore-case",
+		false,
+		"Ignore the case of letters when matching the regular expression.",
+	)
+	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
+
+	registerFlagCompletion(cmd, "filter", completeFilters(
+		config.ListMultiFilters,
+		map[string][]string{"status": config.GetRunStatuses(true)},
+	))
+
+	return cmd
+}
+
+func (o *logsSearchOptions) run(cmd *cobra.Command, pattern string) error {
+	re, err := logsearch.Compile(pattern, o.ignoreCase)
+	if err != nil {
+		return err
+	}
+	if o.context < 0 {
+		return errors.New("invalid value for '--context': it must be a positive number")
+	}
+	since, err := parseReportTime(o.since, "since")
+	if err != nil {
+		return err
+	}
+	until, err := parseReportTime(o.until, "until")
+	if err != nil {
+		return err
+	}
+	statusFilters, searchFilter, filters, err := parseListFilters(o.filters, false, false)
+	if err != nil {
+		return err
+	}
+
+	listParams := operations.NewGetWorkflowsParams()
+	listParams.SetAccessToken(&o.token)
+	listParams.SetType("batch")
+	listParams.SetStatus(statusFilters)
+	listParams.SetSearch(&searchFilter)
+
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	listResp, err := api.Operations.GetWorkflows(listParams)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for _, workflow := range listResp.Payload.Items {
+		created, err := datautils.FromIsoToTimestamp(workflow.Created)
+		if err != nil {
+			return err
+		}
+		if (!since.IsZero() && created.Before(since)) ||
+			(!until.IsZero() && !created.Before(until)) ||
+			!filters.Match(workflowFilterValues(workflow)) {
+			continue
+		}
+		names = append(names, workflow.Name)
+	}
+
+	out := cmd.OutOrStdout()
+	if len(names) == 0 && !o.jsonOutput {
+		displayer.DisplayMessage(
+			"No workflows match the given selection.",
+			displayer.Info,
+			false,
+			out,
+		)
+		return nil
+	}
+
+	matches, failed := searchWorkflowsLogs(api, o.token, names, re, o.context)
+	for _, name := range names {
+		if err, exists := failed[name]; exists {
+			displayer.DisplayMessage(
+				fmt.Sprintf(
+					"Could not retrieve the logs of workflow %s: %s",
+					name,
+					errorhandler.HandleApiError(err).Error(),
+				),
+				displayer.Warning,
+				false,
+				cmd.ErrOrStderr(),
+			)
+		}
+	}
+
+	if o.jsonOutput {
+		if matches == nil {
+			matches = []logMatch{}
+		}
+		return displayer.DisplayJsonOutput(matches, out)
+	}
+	if len(matches) == 0 {
+		displayer.DisplayMessage(
+			fmt.Sprintf("No job logs of %d workflow(s) match '%s'.", len(names), pattern),
+			displayer.Info,
+			false,
+			out,
+		)
+		return nil
+	}
+
+	displayLogMatches(matches, re, true, out)
+	matchingWorkflows := map[string]bool{}
+	lines := 0
+	for _, match := range matches {
+		matchingWorkflows[match.Workflow] = true
+		lines += logsearch.CountMatches([]logsearch.Block{match.Lines})
+	}
+	displayer.DisplayMessage(
+		fmt.Sprintf(
+			"Found %d matching line(s) in %d of %d workflow(s).",
+			lines,
+			len(matchingWorkflows),
+			len(names),
+		),
+		displayer.Info,
+		false,
+		out,
+	)
+	return nil
+}
+
+// searchWorkflowsLogs searches the job logs of the given workflows concurrently.
+// Returns the matches, in the order of the workflows, and the errors of the workflows
+// whose logs could not be retrieved.
+func searchWorkflowsLogs(
+	api *client.API,
+	token string,
+	names []string,
+	re *regexp.Regexp,
+	context int,
+) ([]logMatch, map[string]error) {
+	results := make([][]logMatch, len(names))
+	errs := make([]error, len(names))
+	semaphore := make(chan struct{}, config.BulkConcurrency)
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, workflow string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			log.Infof("Searching the logs of workflow %s", workflow)
+			workflowLogs, err := getWorkflowLogs(api, token, workflow)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			matches := grepLogSources(jobLogSources(workflowLogs.JobLogs), re, context)
+			for j := range matches {
+				matches[j].Workflow = workflow
+			}
+			results[i] = matches
+		}(i, name)
+	}
+	wg.Wait()
+
+	var matches []logMatch
+	failed := map[string]error{}
+	for i, name := range names {
+		if errs[i] != nil {
+			failed[name] = errs[i]
+			continue
+		}
+		matches = append(matches, results[i]...)
+	}
+	return matches, failed
+}
+
+// getWorkflowLogs retrieves all the logs of a workflow.
+func getWorkflowLogs(api *client.API, token, workflow string) (logs, error) {
+	var workflowLogs logs
+	logsParams := operations.NewGetWorkflowLogsParams()
+	logsParams.SetAccessToken(&token)
+	logsParams.SetWorkflowIDOrName(workflow)
+	logsResp, err := api.Operations.GetWorkflowLogs(logsParams)
+	if err != nil {
+		return workflowLogs, err
+	}
+	err = json.Unmarshal([]byte(logsResp.GetPayload().Logs), &workflowLogs)
+	return workflowLogs, err
+}
+
+// allLogSources returns the workflow, engine, service and job logs, in the order they are displayed by logs.
+func allLogSources(workflowLogs logs) []logSource {
+	var sources []logSource
+	if workflowLogs.WorkflowLogs != nil {
+		sources = append(sources, logSource{
+			name:    "workflow engine",
+			content: *workflowLogs.WorkflowLogs,
+		})
+	}
+	if workflowLogs.EngineSpecific != nil {
+		sources = append(sources, logSource{
+			name:    "engine internal",
+			content: *workflowLogs.EngineSpecific,
+		})
+	}
+
+	for _, serviceName := range sortedServiceNames(workflowLogs.ServiceLogs) {
+		for _, entry := range workflowLogs.ServiceLogs[serviceName] {
+			sources = append(sources, logSource{
+				name:    fmt.Sprintf("service %s/%s", serviceName, entry.Component),
+				content: entry.Content,
+			})
+		}
+	}
+
+	return append(sources, jobLogSources(workflowLogs.JobLogs)...)
+}
+
+// sortedServiceNames returns the names of the services in alphabetical order.
+func sortedServiceNames(serviceLogs map[string][]serviceLogItem) []string {
+	serviceNames := make([]string, 0, len(serviceLogs))
+	for serviceName := range serviceLogs {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+	return serviceNames
+}
+
+// jobLogSources returns the logs of the given jobs, sorted by job ID.
+func jobLogSources(jobLogs map[string]jobLogItem) []logSource {
+	jobIDs := make([]string, 0, len(jobLogs))
+	for jobID := range jobLogs {
+		jobIDs = append(jobIDs, jobID)
+	}
+	sort.Strings(jobIDs)
+
+	sources := make([]logSource, 0, len(jobIDs))
+	for _, jobID := range jobIDs {
+		job := jobLogs[jobID]
+		step := job.JobName
+		if step == "" {
+			step = jobID
+		}
+		sources = append(sources, logSource{
+			name:    fmt.Sprintf("step %s", step),
+			step:    step,
+			jobID:   jobID,
+			status:  job.Status,
+			content: job.Logs,
+		})
+	}
+	return sources
+}
+
+// grepLogSources returns the lines of the given logs matching re, with context lines around them.
+func grepLogSources(sources []logSource, re *regexp.Regexp, context int) []logMatch {
+	var matches []logMatch
+	for _, source := range sources {
+		for _, block := range logsearch.Search(source.content, re, context) {
+			matches = append(matches, logMatch{
+				Source: source.name,
+				Step:   source.step,
+				JobID:  source.jobID,
+				Status: source.status,
+				Lines:  block,
+			})
+		}
+	}
+	return matches
+}
+
+// displayLogMatches displays the matching lines grouped by log, highlighting the matching parts.
+// Matching lines are numbered as "12:", context lines as "12-", and separate blocks of the same
+// log are separated by "--", like grep does.
+func displayLogMatches(matches []logMatch, re *regexp.Regexp, showWorkflow bool, out io.Writer) {
+	highlight := func(s string) string {
+		return text.Colors{text.Bold, text.FgRed}.Sprint(s)
+	}
+
+	var previous *logMatch
+	for i, match := range matches {
+		sameSource := previous != nil && previous.Workflow == match.Workflow &&
+			previous.Source == match.Source && previous.JobID == match.JobID
+		if sameSource {
+			fmt.Fprintln(out, "--")
+		} else {
+			title := match.Source
+			if match.JobID != "" {
+				title = fmt.Sprintf("%s (job %s, %s)", title, match.JobID, match.Status)
+			}
+			if showWorkflow {
+				title = fmt.Sprintf("%s: %s", match.Workflow, title)
+			}
+			color := displayer.JobStatusToColor[match.Status]
+			if match.Status == "" {
+				color = text.FgYellow
+			}
+			displayer.PrintColorable(
+				fmt.Sprintf("%s %s\n", config.LeadingMark, title),
+				out,
+				text.Bold,
+				color,
+			)
+		}
+
+		for _, line := range match.Lines {
+			if line.Match {
+				fmt.Fprintf(out, "%6d: %s\n", line.Number, logsearch.Highlight(line.Text, re, highlight))
+			} else {
+				fmt.Fprintf(out, "%6d- %s\n", line.Number, line.Text)
+			}
+		}
+		previous = &matches[i]
+	}
+}
@@ -0,0 +1,117 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestLogsSearch(t *testing.T) {
+	serverResponses := map[string]ServerResponse{
+		listServerPath: {
+			statusCode:   http.StatusOK,
+			responseFile: "logs_search_list.json",
+		},
+		fmt.Sprintf(logsPathTemplate, "fit.2"): {
+			statusCode:   http.StatusOK,
+			responseFile: "logs_complete.json",
+		},
+		fmt.Sprintf(logsPathTemplate, "fit.1"): {
+			statusCode:   http.StatusOK,
+			responseFile: "logs_root_error.json",
+		},
+	}
+
+	tests := map[string]TestCmdParams{
+		"missing regular expression": {
+			args:      []string{},
+			wantError: true,
+			expected:  []string{"accepts 1 arg(s), received 0"},
+		},
+		"invalid regular expression": {
+			args:      []string{"Error("},
+			wantError: true,
+			expected:  []string{"invalid regular expression 'Error('"},
+		},
+		"invalid since": {
+			args:      []string{"--since", "last week", "Error"},
+			wantError: true,
+			expected:  []string{"invalid value for '--since'"},
+		},
+		"invalid filter": {
+			args:      []string{"--filter", "status=unknown", "Error"},
+			wantError: true,
+			expected:  []string{"'unknown' is not a valid value for the filter 'status'"},
+		},
+		"matches": {
+			serverResponses: serverResponses,
+			args:            []string{"--filter", "status=failed", "--since", "2026-01-01", "TFile"},
+			expected: []string{
+				"fit.1: step fit (job 1, failed)",
+				"2: ", ": file data.root does not exist",
+				"Found 1 matching line(s) in 1 of 2 workflow(s).",
+			},
+			unwanted: []string{"fit.2:", "old.1", "workflow engine"},
+		},
+		"no matches": {
+			serverResponses: serverResponses,
+			args:            []string{"--since", "2026-01-01", "segmentation violation"},
+			expected:        []string{"No job logs of 2 workflow(s) match 'segmentation violation'."},
+		},
+		"no workflows": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "logs_search_list.json",
+				},
+			},
+			args:     []string{"--since", "2099-01-01", "Error"},
+			expected: []string{"No workflows match the given selection."},
+		},
+		"json": {
+			serverResponses: serverResponses,
+			args:            []string{"--since", "2026-01-01", "--json", "logs"},
+			expected: []string{
+				"\"workflow\": \"fit.2\"", "\"source\": \"step job1\"",
+				"\"text\": \"workflow 1 logs\"",
+			},
+			unwanted: []string{"\"workflow\": \"fit.1\""},
+		},
+		"logs not available": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "logs_search_list.json",
+				},
+				fmt.Sprintf(logsPathTemplate, "fit.2"): {
+					statusCode:   http.StatusNotFound,
+					responseFile: "common_empty.json",
+				},
+				fmt.Sprintf(logsPathTemplate, "fit.1"): {
+					statusCode:   http.StatusOK,
+					responseFile: "logs_root_error.json",
+				},
+			},
+			args: []string{"--since", "2026-01-01", "TFile"},
+			expected: []string{
+				"Could not retrieve the logs of workflow fit.2",
+				"Found 1 matching line(s) in 1 of 2 workflow(s).",
+			},
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "logs-search"
+			testCmdRun(t, params)
+		})
+	}
+}
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2024, 2025 CERN.
+Copyright (C) 2022, 2024, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -108,6 +108,83 @@ func TestLogs(t *testing.T) {
 			expected: []string{"Step: job1"},
 			unwanted: []string{"Step: job2"},
 		},
+		"all pages": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(logsPathTemplate, workflowName): {
+					statusCode:              http.StatusOK,
+					responseFile:            "logs_complete.json",
+					additionalResponseFiles: []string{"logs_page_2.json"},
+				},
+			},
+			args: []string{"-w", workflowName, "--size", "2", "--all-pages"},
+			expected: []string{
+				"Service: dask-service-12345abc",
+				"Step: job1", "Step: job2", "Step: job3", "workflow 3 logs",
+			},
+		},
+		"all pages ignored by the server": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(logsPathTemplate, workflowName): {
+					statusCode:              http.StatusOK,
+					responseFile:            "logs_complete.json",
+					additionalResponseFiles: []string{"logs_complete.json"},
+				},
+			},
+			args:     []string{"-w", workflowName, "--size", "2", "--all-pages"},
+			expected: []string{"Step: job1", "Step: job2"},
+		},
+		"limit with filters": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(logsPathTemplate, workflowName): {
+					statusCode:              http.StatusOK,
+					responseFile:            "logs_complete.json",
+					additionalResponseFiles: []string{"logs_page_2.json"},
+				},
+			},
+			args: []string{
+				"-w", workflowName, "--size", "2", "--all-pages",
+				"--filter", "compute_backend=kubernetes", "--limit", "2",
+			},
+			expected: []string{"Step: job1", "Step: job3"},
+			unwanted: []string{"Step: job2"},
+		},
+		"limit": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(logsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "logs_complete.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "--limit", "1"},
+			expected: []string{"Step: job1"},
+			unwanted: []string{"Step: job2"},
+		},
+		"limit with follow": {
+			args: []string{"-w", workflowName, "--limit", "1", "--follow"},
+			expected: []string{
+				"--all-pages and --limit cannot be used together with --follow",
+			},
+			wantError: true,
+		},
+		"filter expression": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(logsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "logs_complete.json",
+				},
+			},
+			args: []string{
+				"-w", workflowName,
+				"--filter", "compute_backend!=kubernetes and started_at>2022-07-21",
+			},
+			expected: []string{"Step: job2"},
+			unwanted: []string{"Step: job1"},
+		},
+		"filter expression with follow": {
+			args:      []string{"-w", workflowName, "--filter", "step~fit", "--follow"},
+			expected:  []string{"filter expressions cannot be used together with --follow"},
+			wantError: true,
+		},
 		"missing step names": {
 			serverResponses: map[string]ServerResponse{
 				fmt.Sprintf(logsPathTemplate, workflowName): {
@@ -201,15 +278,34 @@ func TestLogs(t *testing.T) {
 				"step",
 			},
 		},
+		"follow not supported by old server": {
+			serverResponses: map[string]ServerResponse{
+				pingServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "ping.json",
+				},
+			},
+			args: []string{"-w", workflowName, "--follow"},
+			expected: []string{
+				"--follow is not supported by server 0.9, it requires REANA 0.95 or newer",
+			},
+			wantError: true,
+		},
 		"follow job with multiple steps, size, interval and json flags": {
 			serverResponses: map[string]ServerResponse{
 				fmt.Sprintf(logsPathTemplate, workflowName): {
 					statusCode:   http.StatusOK,
 					responseFile: "logs_running.json",
 					additionalResponseFiles: []string{
+						"logs_running.json",
 						"logs_complete_live.json",
+						"logs_finished_live.json",
 					},
 				},
+				fmt.Sprintf(statusPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "status_running.json",
+				},
 			},
 			args: []string{
 				"-w",
@@ -227,9 +323,16 @@ func TestLogs(t *testing.T) {
 			},
 			expected: []string{
 				"Ignoring --json as it cannot be used together with --follow.",
-				"Only one step can be followed at a time, ignoring additional steps.",
-				"workflow 1 logs",
-				"Job has completed, you might want to rerun the command without the --follow flag.",
+				"Following step job1 (job 1).",
+				"Waiting for step(s) job2 to start...",
+				"Following step job2 (job 2).",
+				"[job1] ", "workflow 1 logs",
+				"[job2] ", "workflow 2 logs", "restarted job 2", "done",
+				"The logs of step job2 (job 2) were truncated or rotated, showing them from the start.",
+				"Jobs have completed, you might want to rerun the command without the --follow flag.",
+			},
+			unwanted: []string{
+				"Only one step can be followed at a time",
 			},
 		},
 		"follow job that does not exist": {
@@ -238,6 +341,10 @@ func TestLogs(t *testing.T) {
 					statusCode:   http.StatusOK,
 					responseFile: "logs_empty.json",
 				},
+				fmt.Sprintf(statusPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "status_finished.json",
+				},
 			},
 			args: []string{
 				"-w",
@@ -312,6 +419,74 @@ func TestLogs(t *testing.T) {
 			},
 			wantError: true,
 		},
+		"grep": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(logsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "logs_root_error.json",
+				},
+			},
+			args: []string{"-w", workflowName, "--grep", "Error in"},
+			expected: []string{
+				"workflow engine", "2: ",
+				"step fit (job 1, failed)", "2: ", ": file data.root does not exist",
+			},
+			unwanted: []string{"opening file", "closing", "step plot", "Docker image:"},
+		},
+		"grep with context": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(logsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "logs_root_error.json",
+				},
+			},
+			args: []string{
+				"-w", workflowName, "--grep", "tfile", "--ignore-case", "-C", "1",
+			},
+			expected: []string{
+				"step fit (job 1, failed)", "1- opening file", "3- closing",
+			},
+			unwanted: []string{"workflow engine", "starting workflow"},
+		},
+		"grep json": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(logsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "logs_root_error.json",
+				},
+			},
+			args: []string{"-w", workflowName, "--grep", "TFile", "--json"},
+			expected: []string{
+				"\"source\": \"step fit\"", "\"step\": \"fit\"", "\"job_id\": \"1\"",
+				"\"line\": 2", "\"match\": true",
+			},
+		},
+		"grep without matches": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(logsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "logs_root_error.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "--grep", "segmentation violation"},
+			expected: []string{"No log lines match 'segmentation violation'."},
+		},
+		"grep invalid regular expression": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(logsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "logs_root_error.json",
+				},
+			},
+			args:      []string{"-w", workflowName, "--grep", "Error("},
+			expected:  []string{"invalid regular expression 'Error('"},
+			wantError: true,
+		},
+		"grep and follow": {
+			args:      []string{"-w", workflowName, "--grep", "Error", "--follow"},
+			expected:  []string{"please provide either --grep or --follow, not both"},
+			wantError: true,
+		},
 	}
 
 	for name, params := range tests {
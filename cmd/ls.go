@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2023, 2025 CERN.
+Copyright (C) 2022, 2023, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -9,14 +9,17 @@ under the terms of the MIT License; see LICENSE file for more details.
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"reanahub/reana-client-go/client"
-	"reanahub/reana-client-go/client/operations"
 	"reanahub/reana-client-go/pkg/config"
 	"reanahub/reana-client-go/pkg/datautils"
 	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/filetree"
 	"reanahub/reana-client-go/pkg/filterer"
 	"reanahub/reana-client-go/pkg/formatter"
+	"reanahub/reana-client-go/pkg/glob"
+	"strconv"
 
 	"github.com/go-gota/gota/dataframe"
 	"github.com/go-gota/gota/series"
@@ -33,7 +36,22 @@ List workspace files.
 The ` + "``ls``" + ` command lists workspace files of a workflow specified by the
 environment variable REANA_WORKON or provided as a command-line flag
 ` + "``--workflow`` or ``-w``." + ` The SOURCE argument is optional and specifies a
-pattern matching files and directories.
+pattern matching files and directories: '*' matches any characters except '/',
+'**' matches any characters including '/', and braces list alternatives, e.g.
+'{a,b}'. All the files are listed, unless a page is requested with ` + "``--page``" + `
+or ` + "``--size``" + ` without ` + "``--all-pages``" + `. The files are displayed as soon as
+each page is retrieved, and ` + "``--limit``" + ` stops after the given number of files.
+The files can be sorted by several columns with ` + "``--sort``" + `, e.g.
+` + "``--sort -size,name``" + `, in which case they are displayed once all the pages are
+retrieved.
+
+The ` + "``--tree``" + ` option displays the files as a directory hierarchy, with the
+total size and number of files of each directory, down to the depth given by
+` + "``--depth``" + `.
+
+The displayed columns can be chosen, renamed and computed with ` + "``--columns``" + `, and
+the files can be aggregated with ` + "``--agg``" + `, possibly by group of files sharing
+the values of the ` + "``--group-by``" + ` columns.
 
 Examples:
 
@@ -43,7 +61,19 @@ Examples:
 
   $ reana-client ls --workflow myanalysis.42 'data/*root*'
 
+  $ reana-client ls --workflow myanalysis.42 '**/*.{png,pdf}'
+
+  $ reana-client ls --workflow myanalysis.42 --tree --depth 2 -h
+
   $ reana-client ls --workflow myanalysis.42 --filter name=hello
+
+  $ reana-client ls --workflow myanalysis.42 --sort -last-modified
+
+  $ reana-client ls --workflow myanalysis.42 --columns name,size_mib
+
+  $ reana-client ls --workflow myanalysis.42 'results/**' --agg count,sum(size)
+
+  $ reana-client ls --workflow myanalysis.42 --shared-by alice@cern.ch
 `
 
 const lsFormatFlagDesc = `Format output according to column titles or column
@@ -54,7 +84,9 @@ E.g.display files named data.txt
 const lsFilterFlagDesc = `Filter results to show only files that match certain filtering criteria such as
 file name, size or modification date.
 Use --filter <column_name>=<column_value> pairs. Available
-filters are 'name', 'size' and 'last-modified'.`
+filters are 'name', 'size' and 'last-modified'. Filter expressions
+such as 'size>1GiB and last-modified>=2026-01-01' or 'name~\.root$'
+can also use the operators '!=', '>', '>=', '<', '<=', '~' and '!~'.`
 
 type lsOptions struct {
 	token         string
@@ -63,11 +95,17 @@ type lsOptions struct {
 	formatFilters []string
 	jsonOutput    bool
 	displayURLs   bool
-	humanReadable bool
 	filters       []string
 	page          int64
 	size          int64
 	fileName      string
+	sharedBy      string
+	tree          bool
+	depth         int
+	paginationOptions
+	tableOptions
+	sortOptions
+	sizeOptions
 }
 
 // newLsCmd creates a command to list workspace files.
@@ -84,6 +122,24 @@ func newLsCmd() *cobra.Command {
 			if len(args) > 0 {
 				o.fileName = args[0]
 			}
+			if err := o.validateSizeFlags(); err != nil {
+				return err
+			}
+			if o.depth < 0 {
+				return errors.New("invalid value for '--depth': it must be a positive number")
+			}
+			if cmd.Flags().Changed("depth") && !o.tree {
+				return errors.New("--depth can only be used together with --tree")
+			}
+			if o.tree && (o.displayURLs || len(o.formatFilters) > 0) {
+				return errors.New("--tree cannot be used together with --url or --format")
+			}
+			if o.tree && (len(o.columns) > 0 || len(o.groupBy) > 0 || len(o.aggregations) > 0) {
+				return errors.New("--tree cannot be used together with --columns, --group-by or --agg")
+			}
+			if (o.tree || o.displayURLs) && len(o.sortColumns) > 0 {
+				return errors.New("--sort cannot be used together with --tree or --url")
+			}
 			return o.run(cmd)
 		},
 	}
@@ -106,13 +162,7 @@ func newLsCmd() *cobra.Command {
 	f.StringSliceVar(&o.formatFilters, "format", []string{}, lsFormatFlagDesc)
 	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
 	f.BoolVar(&o.displayURLs, "url", false, "Get URLs of output files.")
-	f.BoolVarP(
-		&o.humanReadable,
-		"human-readable",
-		"h",
-		false,
-		"Show disk size in human readable format.",
-	)
+	addSizeFlags(f, &o.sizeOptions, "Show disk size in human readable format.", false)
 	f.StringSliceVar(&o.filters, "filter", []string{}, lsFilterFlagDesc)
 	f.Int64Var(
 		&o.page,
@@ -126,16 +176,45 @@ func newLsCmd() *cobra.Command {
 		0,
 		"Number of results per page (to be used with --page).",
 	)
+	f.StringVar(
+		&o.sharedBy,
+		"shared-by",
+		"",
+		`Email of the user who shared the workflow
+with you, to access a workflow you do not own.`,
+	)
+	addPaginationFlags(f, &o.paginationOptions)
+	addTableFlags(f, &o.tableOptions)
+	addSortFlag(f, &o.sortOptions, []string{})
+	f.BoolVar(
+		&o.tree,
+		"tree",
+		false,
+		"Display the files as a directory tree, with the total size of each directory.",
+	)
+	f.IntVar(
+		&o.depth,
+		"depth",
+		0,
+		"Maximum depth of the directory tree (to be used with --tree). Unlimited by default.",
+	)
 	// Remove -h shorthand
 	cmd.PersistentFlags().BoolP("help", "", false, "Help for ls")
 
+	cmd.ValidArgsFunction = completeUpToNArgs(1, completeWorkspacePaths)
+	registerFlagCompletion(cmd, "filter", completeFilters(lsColumns, nil))
+	registerFlagCompletion(cmd, "format", completeColumns(lsColumns))
+	registerFlagCompletion(cmd, "columns", completeColumns(lsColumns))
+	registerFlagCompletion(cmd, "group-by", completeColumns(lsColumns))
+	registerFlagCompletion(cmd, "sort", completeSortColumns(lsColumns))
+
 	return cmd
 }
 
 func (o *lsOptions) run(cmd *cobra.Command) error {
-	header := []string{"name", "size", "last-modified"}
+	header := lsColumns
 
-	filters, err := filterer.NewFilters(nil, header, o.filters)
+	filters, err := filterer.NewTypedFilters(nil, header, lsFilterTypes, o.filters)
 	if err != nil {
 		return err
 	}
@@ -144,73 +223,151 @@ func (o *lsOptions) run(cmd *cobra.Command) error {
 		return err
 	}
 
+	if o.sharedBy != "" {
+		workflowID, err := getSharedWorkflowID(o.token, o.sharedBy, o.workflow)
+		if err != nil {
+			return err
+		}
+		o.workflow = workflowID
+	}
 	log.Infof("Workflow %s selected", o.workflow)
 
-	lsParams := operations.NewGetFilesParams()
-	lsParams.SetAccessToken(&o.token)
-	lsParams.SetWorkflowIDOrName(o.workflow)
-	lsParams.SetFileName(&o.fileName)
-	lsParams.SetSearch(&searchFilter)
-	lsParams.SetPage(&o.page)
-	if cmd.Flags().Changed("size") {
-		lsParams.SetSize(&o.size)
+	var pattern *glob.Pattern
+	serverFileName := o.fileName
+	if o.fileName != "" {
+		pattern, err = glob.Compile(o.fileName)
+		if err != nil {
+			return err
+		}
+		// Patterns are matched locally, plain paths can be resolved by the server.
+		if glob.HasMeta(o.fileName) {
+			serverFileName = ""
+		}
 	}
 
-	api, err := client.ApiClient()
+	paginatorOptions, err := o.paginatorOptions(cmd, o.page, o.size, config.WorkspaceFilesPageSize)
+	if err != nil {
+		return err
+	}
+	report, err := o.report(o.formatFilters)
+	if err != nil {
+		return err
+	}
+	sortKeys, err := o.sortKeys()
 	if err != nil {
 		return err
 	}
-	lsResp, err := api.Operations.GetFiles(lsParams)
+	api, err := client.ApiClient()
 	if err != nil {
 		return err
 	}
+	files := newWorkspaceFilesPaginator(
+		api,
+		o.token,
+		o.workflow,
+		serverFileName,
+		searchFilter,
+		paginatorOptions,
+	).Filter(func(file *workspaceFile) bool {
+		return !datautils.HasAnyPrefix(file.Name, config.FilesBlacklist) &&
+			(pattern == nil || pattern.Select(file.Name)) &&
+			filters.Match(workspaceFileFilterValues(file))
+	})
+
+	if o.tree {
+		allFiles, err := files.All()
+		if err != nil {
+			return err
+		}
+		return displayLsTree(cmd, allFiles, o.depth, o.jsonOutput, o.sizeOptions)
+	}
 
 	parsedFormatFilters := formatter.ParseFormatParameters(
 		o.formatFilters,
 		true,
 	)
-	if o.displayURLs {
-		displayLsURLs(cmd, lsResp.Payload, o.serverURL, o.workflow)
-	} else {
-		err = displayLsFiles(
-			cmd,
-			lsResp.Payload,
-			header,
-			parsedFormatFilters,
-			o.jsonOutput,
-			o.humanReadable,
-		)
+	stream := newDataFrameStream(cmd.OutOrStdout(), o.jsonOutput)
+	display := func(files []*workspaceFile) error {
+		df, err := buildLsDataFrame(files, header, parsedFormatFilters, sortKeys, o.sizeOptions)
+		if err != nil {
+			return err
+		}
+		df, err = report.Apply(df)
 		if err != nil {
 			return err
 		}
+		return stream.append(df)
+	}
+	// Sorted and grouped rows need all the pages.
+	collectPages := len(sortKeys) > 0 || report.Groups()
+	var allFiles []*workspaceFile
+	for {
+		page, ok, err := files.NextPage()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		if o.displayURLs {
+			displayLsURLs(cmd, page, o.serverURL, o.workflow)
+			continue
+		}
+		if collectPages {
+			allFiles = append(allFiles, page...)
+		} else if err := display(page); err != nil {
+			return err
+		}
+	}
+	if o.displayURLs {
+		return nil
+	}
+	if collectPages {
+		if err := display(allFiles); err != nil {
+			return err
+		}
 	}
+	return stream.close()
+}
 
-	return nil
+// lsFilterTypes types of the file fields compared in filter expressions.
+var lsFilterTypes = map[string]filterer.ValueType{
+	"size":          filterer.SizeValue,
+	"last-modified": filterer.DateValue,
 }
 
-func displayLsFiles(
-	cmd *cobra.Command,
-	p *operations.GetFilesOKBody,
+// workspaceFileFilterValues returns the values of the file fields compared in filter expressions.
+func workspaceFileFilterValues(file *workspaceFile) map[string]string {
+	values := map[string]string{
+		"name":          file.Name,
+		"last-modified": file.LastModified,
+	}
+	if file.Size != nil {
+		values["size"] = strconv.FormatInt(file.Size.Raw, 10)
+	}
+	return values
+}
+
+// buildLsDataFrame returns the given columns of the files, sorted by the given keys and formatted according
+// to the format filters.
+func buildLsDataFrame(
+	files []*workspaceFile,
 	header []string,
 	formatFilters []formatter.FormatFilter,
-	jsonOutput bool,
-	humanReadable bool,
-) error {
+	sortKeys []formatter.SortKey,
+	sizes sizeOptions,
+) (dataframe.DataFrame, error) {
 	var df dataframe.DataFrame
 	for _, col := range header {
-		colSeries := buildLsSeries(col, humanReadable)
-		for _, file := range p.Items {
-			if datautils.HasAnyPrefix(file.Name, config.FilesBlacklist) {
-				continue
-			}
-
+		colSeries := buildLsSeries(col, sizes.showHumanReadable())
+		for _, file := range files {
 			var value any
 			switch col {
 			case "name":
 				value = file.Name
 			case "size":
-				if humanReadable {
-					value = file.Size.HumanReadable
+				if sizes.showHumanReadable() {
+					value = sizes.formatSize(file.Size.Raw)
 				} else {
 					value = int(file.Size.Raw)
 				}
@@ -224,21 +381,37 @@ func displayLsFiles(
 		df = df.CBind(dataframe.New(colSeries))
 	}
 
-	df, err := formatter.FormatDataFrame(df, formatFilters)
+	df, err := formatter.SortDataFrame(df, sortKeys)
 	if err != nil {
-		return err
+		return df, err
 	}
+	return formatter.FormatDataFrame(df, formatFilters)
+}
 
-	if jsonOutput {
-		err := displayer.DisplayJsonOutput(df.Maps(), cmd.OutOrStdout())
-		if err != nil {
-			return err
+// displayLsTree displays the files as a directory tree, down to the given depth if it is positive.
+func displayLsTree(
+	cmd *cobra.Command,
+	files []*workspaceFile,
+	depth int,
+	jsonOutput bool,
+	sizes sizeOptions,
+) error {
+	var treeFiles []filetree.File
+	for _, file := range files {
+		treeFile := filetree.File{Path: file.Name, LastModified: file.LastModified}
+		if file.Size != nil {
+			treeFile.Size = file.Size.Raw
 		}
-	} else {
-		data := formatter.DataFrameToStringData(df)
-		displayer.DisplayTable(df.Names(), data, cmd.OutOrStdout())
+		treeFiles = append(treeFiles, treeFile)
 	}
+	root := filetree.Build(treeFiles).Prune(depth)
 
+	if jsonOutput {
+		return displayer.DisplayJsonOutput(root, cmd.OutOrStdout())
+	}
+	for _, line := range root.Render(sizes.formatSize) {
+		fmt.Fprintln(cmd.OutOrStdout(), line)
+	}
 	return nil
 }
 
@@ -251,11 +424,11 @@ func buildLsSeries(col string, humanReadable bool) series.Series {
 
 func displayLsURLs(
 	cmd *cobra.Command,
-	p *operations.GetFilesOKBody,
+	files []*workspaceFile,
 	serverURL string,
 	workflow string,
 ) {
-	for _, file := range p.Items {
+	for _, file := range files {
 		fileURL := fmt.Sprintf(
 			"%s/api/workflows/%s/workspace/%s",
 			serverURL,
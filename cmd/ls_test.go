@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -49,6 +49,22 @@ func TestLs(t *testing.T) {
 				"results/data.root", "150.83 KiB", "2022-07-11T13:30:17",
 			},
 		},
+		"human readable in SI units": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "-h", "--units", "si"},
+			expected: []string{"code/gendata.C", "1.94 kB", "results/data.root", "154.46 kB"},
+			unwanted: []string{"KiB"},
+		},
+		"human readable and bytes": {
+			args:      []string{"-w", workflowName, "-h", "--bytes"},
+			expected:  []string{"please provide either --human-readable or --bytes, not both"},
+			wantError: true,
+		},
 		"files in black list": {
 			serverResponses: map[string]ServerResponse{
 				fmt.Sprintf(lsPathTemplate, workflowName): {
@@ -193,6 +209,193 @@ func TestLs(t *testing.T) {
 				"code/gendata.C", "1937", "2022-07-11T12:50:33",
 			},
 		},
+		"glob pattern": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+			},
+			args: []string{"-w", workflowName, "**/*.{root,png}"},
+			expected: []string{
+				"NAME", "SIZE", "LAST-MODIFIED",
+				"results/data.root", "154455", "2022-07-11T13:30:17",
+			},
+			unwanted: []string{"code/gendata.C"},
+		},
+		"invalid glob pattern": {
+			args:      []string{"-w", workflowName, "data/[a-z"},
+			expected:  []string{"invalid pattern 'data/[a-z': unterminated character class"},
+			wantError: true,
+		},
+		"all pages": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:              http.StatusOK,
+					responseFile:            "ls_page_1.json",
+					additionalResponseFiles: []string{"ls_page_2.json"},
+				},
+			},
+			args: []string{"-w", workflowName},
+			expected: []string{
+				"code/gendata.C", "results/data.root", "results/plots/plot.png",
+			},
+		},
+		"limit": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:              http.StatusOK,
+					responseFile:            "ls_page_1.json",
+					additionalResponseFiles: []string{"ls_page_2.json"},
+				},
+			},
+			args:     []string{"-w", workflowName, "--limit", "1"},
+			expected: []string{"code/gendata.C"},
+			unwanted: []string{"results/data.root", "results/plots/plot.png"},
+		},
+		"single page": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:              http.StatusOK,
+					responseFile:            "ls_page_1.json",
+					additionalResponseFiles: []string{"ls_page_2.json"},
+				},
+			},
+			args:     []string{"-w", workflowName, "--size", "2"},
+			expected: []string{"code/gendata.C", "results/data.root"},
+			unwanted: []string{"results/plots/plot.png"},
+		},
+		"filter expression": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:              http.StatusOK,
+					responseFile:            "ls_page_1.json",
+					additionalResponseFiles: []string{"ls_page_2.json"},
+				},
+			},
+			args: []string{
+				"-w", workflowName,
+				"--filter", "size>=10KiB and last-modified<2022-07-11T13:00:00 or name~\\.png$",
+			},
+			expected: []string{"results/plots/plot.png"},
+			unwanted: []string{"code/gendata.C", "results/data.root"},
+		},
+		"custom columns": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_page_1.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "--size", "2", "--columns", "name:File,size_kib"},
+			expected: []string{"FILE", "SIZE_KIB", "code/gendata.C", "1.89", "150.83"},
+			unwanted: []string{"LAST-MODIFIED"},
+		},
+		"aggregates over all pages": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:              http.StatusOK,
+					responseFile:            "ls_page_1.json",
+					additionalResponseFiles: []string{"ls_page_2.json"},
+				},
+			},
+			args:     []string{"-w", workflowName, "--agg", "count,sum(size)", "--json"},
+			expected: []string{`"count": 3`, `"sum(size)": 176872`},
+		},
+		"sorted over all pages": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:              http.StatusOK,
+					responseFile:            "ls_page_1.json",
+					additionalResponseFiles: []string{"ls_page_2.json"},
+				},
+			},
+			args:     []string{"-w", workflowName, "-h", "--sort", "-size", "--columns", "name"},
+			expected: []string{"results/data.root     \nresults/plots/plot.png\ncode/gendata.C"},
+		},
+		"tree sorted": {
+			args:      []string{"-w", workflowName, "--tree", "--sort", "name"},
+			expected:  []string{"--sort cannot be used together with --tree or --url"},
+			wantError: true,
+		},
+		"invalid sort column": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_page_1.json",
+				},
+			},
+			args:      []string{"-w", workflowName, "--sort", "owner"},
+			expected:  []string{"column 'owner' does not exist"},
+			wantError: true,
+		},
+		"tree with columns": {
+			args:      []string{"-w", workflowName, "--tree", "--columns", "name"},
+			expected:  []string{"--tree cannot be used together with --columns, --group-by or --agg"},
+			wantError: true,
+		},
+		"tree": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:              http.StatusOK,
+					responseFile:            "ls_page_1.json",
+					additionalResponseFiles: []string{"ls_page_2.json"},
+				},
+			},
+			args: []string{"-w", workflowName, "--tree", "-h"},
+			expected: []string{
+				". (172.73 KiB, 3 files)",
+				"├── code/ (1.89 KiB, 1 file)",
+				"│   └── gendata.C (1.89 KiB)",
+				"└── results/ (170.83 KiB, 2 files)",
+				"    ├── data.root (150.83 KiB)",
+				"    └── plots/ (20 KiB, 1 file)",
+				"        └── plot.png (20 KiB)",
+			},
+		},
+		"tree with depth": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:              http.StatusOK,
+					responseFile:            "ls_page_1.json",
+					additionalResponseFiles: []string{"ls_page_2.json"},
+				},
+			},
+			args: []string{"-w", workflowName, "--tree", "--depth", "1"},
+			expected: []string{
+				". (176872, 3 files)",
+				"├── code/ (1937, 1 file)",
+				"└── results/ (174935, 2 files)",
+			},
+			unwanted: []string{"data.root", "plots/"},
+		},
+		"tree in JSON format": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+			},
+			args: []string{"-w", workflowName, "--tree", "--json", "results"},
+			expected: []string{
+				`"name": "results"`,
+				`"directory": true`,
+				`"name": "data.root"`,
+				`"size": 154455`,
+				`"last-modified": "2022-07-11T13:30:17"`,
+			},
+			unwanted: []string{"gendata.C"},
+		},
+		"depth without tree": {
+			args:      []string{"-w", workflowName, "--depth", "2"},
+			expected:  []string{"--depth can only be used together with --tree"},
+			wantError: true,
+		},
+		"tree with format": {
+			args:      []string{"-w", workflowName, "--tree", "--format", "name"},
+			expected:  []string{"--tree cannot be used together with --url or --format"},
+			wantError: true,
+		},
 		"malformed filters": {
 			args: []string{"-w", workflowName, "--filter", "name"},
 			expected: []string{
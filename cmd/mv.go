@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -66,6 +66,8 @@ func newMvCmd() *cobra.Command {
 		"Name or UUID of the workflow. Overrides value of REANA_WORKON environment variable.",
 	)
 
+	cmd.ValidArgsFunction = completeUpToNArgs(2, completeWorkspacePaths)
+
 	return cmd
 }
 
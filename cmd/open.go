@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2023, 2025 CERN.
+Copyright (C) 2022, 2023, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -9,19 +9,26 @@ under the terms of the MIT License; see LICENSE file for more details.
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"reanahub/reana-client-go/client"
 	"reanahub/reana-client-go/client/operations"
 	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/datautils"
+	"reanahub/reana-client-go/pkg/desktop"
 	"reanahub/reana-client-go/pkg/displayer"
 	"reanahub/reana-client-go/pkg/formatter"
+	"reanahub/reana-client-go/pkg/sessiontypes"
 	"reanahub/reana-client-go/pkg/validator"
+	"strings"
+	"time"
 
 	"github.com/jedib0t/go-pretty/v6/text"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
 )
 
 const openDesc = `
@@ -31,9 +38,26 @@ The ` + "``open``" + ` command allows to open interactive session processes on t
 the workflow workspace, such as Jupyter notebooks. This is useful to quickly
 inspect and analyse the produced files while the workflow is still running.
 
+Jupyter notebooks are always available. Other types of interactive sessions,
+such as VS Code or RStudio servers, can be opened when the server supports
+them; use ` + "``session-types``" + ` to list them together with their images.
+
+Use ` + "``--wait``" + ` to wait until the interactive session is ready and open it in
+your web browser, and ` + "``--copy-url``" + ` to copy the session URL to the
+clipboard instead of printing it together with your access token.
+
+If the server does not allow custom images, the image passed with ` + "``--image``" + `
+must be one of the recommended ones. Use ` + "``--pick-image``" + ` to choose one of them.
+
 Examples:
 
   $ reana-client open -w myanalysis.42 jupyter
+
+  $ reana-client open -w myanalysis.42 rstudio
+
+  $ reana-client open -w myanalysis.42 --wait
+
+  $ reana-client open -w myanalysis.42 --pick-image --copy-url
 `
 
 const openImageFlagDesc = `Docker image which will be used to spawn the
@@ -46,8 +70,18 @@ type openOptions struct {
 	workflow               string
 	image                  string
 	interactiveSessionType string
+	wait                   bool
+	waitTimeout            string
+	copyURL                bool
+	pickImage              bool
 }
 
+// openBrowser and copyToClipboard interact with the desktop of the user, they can be replaced in tests.
+var (
+	openBrowser     = desktop.OpenURL
+	copyToClipboard = desktop.CopyToClipboard
+)
+
 // newOpenCmd creates a command to open an interactive session inside the workspace.
 func newOpenCmd() *cobra.Command {
 	o := &openOptions{}
@@ -59,16 +93,12 @@ func newOpenCmd() *cobra.Command {
 		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			o.serverURL = viper.GetString("server-url")
-			o.interactiveSessionType = config.InteractiveSessionTypes[0]
+			o.interactiveSessionType = sessiontypes.DefaultType
 			if len(args) > 0 {
 				o.interactiveSessionType = args[0]
 			}
-			if err := validator.ValidateChoice(
-				o.interactiveSessionType,
-				config.InteractiveSessionTypes,
-				"interactive-session-type",
-			); err != nil {
-				return err
+			if o.image != "" && o.pickImage {
+				return errors.New("please provide either --image or --pick-image, not both")
 			}
 			return o.run(cmd)
 		},
@@ -90,11 +120,63 @@ func newOpenCmd() *cobra.Command {
 		"Name or UUID of the workflow. Overrides value of REANA_WORKON environment variable.",
 	)
 	f.StringVarP(&o.image, "image", "i", "", openImageFlagDesc)
+	f.BoolVar(
+		&o.pickImage,
+		"pick-image",
+		false,
+		"Choose the image among the ones recommended by the server.",
+	)
+	f.BoolVar(
+		&o.wait,
+		"wait",
+		false,
+		"Wait until the interactive session is ready and open it in the web browser.",
+	)
+	f.StringVar(
+		&o.waitTimeout,
+		"wait-timeout",
+		"10m",
+		"Maximum time to wait for the interactive session to be ready (e.g. 30s, 10m).",
+	)
+	f.BoolVar(
+		&o.copyURL,
+		"copy-url",
+		false,
+		"Copy the session URL to the clipboard instead of printing it.",
+	)
 
 	return cmd
 }
 
 func (o *openOptions) run(cmd *cobra.Command) error {
+	waitTimeout, err := datautils.ParseDuration(o.waitTimeout)
+	if err != nil {
+		return fmt.Errorf("invalid value for '--wait-timeout': %s", err.Error())
+	}
+
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	out := cmd.OutOrStdout()
+
+	info, err := getServerInfo(api, o.token, false)
+	if err != nil {
+		log.Debugf("Could not retrieve the server info: %v", err)
+	}
+	types := getSessionTypes(api, o.token, info)
+	if err := validator.ValidateChoice(
+		o.interactiveSessionType,
+		sessiontypes.Names(types),
+		"interactive-session-type",
+	); err != nil {
+		return err
+	}
+	sessionType, _ := sessiontypes.Find(types, o.interactiveSessionType)
+	if err := o.selectImage(cmd, info, sessionType); err != nil {
+		return err
+	}
+
 	openParams := operations.NewOpenInteractiveSessionParams()
 	openParams.SetAccessToken(&o.token)
 	openParams.SetWorkflowIDOrName(o.workflow)
@@ -103,10 +185,6 @@ func (o *openOptions) run(cmd *cobra.Command) error {
 		operations.OpenInteractiveSessionBody{Image: o.image},
 	)
 
-	api, err := client.ApiClient()
-	if err != nil {
-		return err
-	}
 	log.Infof("Opening an interactive session on %s", o.workflow)
 	openResp, err := api.Operations.OpenInteractiveSession(openParams)
 	if err != nil {
@@ -117,25 +195,32 @@ func (o *openOptions) run(cmd *cobra.Command) error {
 		"Interactive session opened successfully",
 		displayer.Success,
 		false,
-		cmd.OutOrStdout(),
+		out,
 	)
 	sessionURI := formatter.FormatSessionURI(
 		o.serverURL,
 		openResp.Payload.Path,
 		o.token,
 	)
-	displayer.PrintColorable(sessionURI+"\n", cmd.OutOrStdout(), text.FgGreen)
-	cmd.Println(
-		"It could take several minutes to start the interactive session.",
-	)
+	if err := o.displaySessionURI(cmd, sessionURI, o.serverURL+openResp.Payload.Path); err != nil {
+		return err
+	}
+	cmd.Println(sessionType.ConnectionHint)
 
-	infoParams := operations.NewInfoParams()
-	infoParams.SetAccessToken(o.token)
-	infoResp, err := api.Operations.Info(infoParams)
-	if err != nil {
+	if o.wait {
+		if err := o.waitAndOpen(cmd, api, sessionURI, waitTimeout); err != nil {
+			return err
+		}
+	} else {
+		cmd.Println(
+			"It could take several minutes to start the interactive session.",
+		)
+	}
+
+	if info == nil {
 		return nil
 	}
-	maxInactivityDays := infoResp.Payload.MaximumInteractiveSessionInactivityPeriod
+	maxInactivityDays := info.MaximumInteractiveSessionInactivityPeriod
 	if maxInactivityDays != nil && maxInactivityDays.Value != nil {
 		cmd.Println(
 			fmt.Sprintf(
@@ -147,3 +232,140 @@ func (o *openOptions) run(cmd *cobra.Command) error {
 
 	return nil
 }
+
+// selectImage lets the user pick one of the images recommended for the session type if requested,
+// and checks that the selected image is allowed by the server.
+func (o *openOptions) selectImage(
+	cmd *cobra.Command,
+	info *operations.InfoOKBody,
+	sessionType sessiontypes.Type,
+) error {
+	recommended := sessionType.RecommendedImages
+	customAllowed := true
+	if info != nil {
+		if info.InteractiveSessionsCustomImageAllowed != nil {
+			customAllowed = strings.ToLower(
+				info.InteractiveSessionsCustomImageAllowed.Value,
+			) == "true"
+		}
+	}
+
+	if o.pickImage {
+		if len(recommended) == 0 {
+			return fmt.Errorf(
+				"the server does not recommend any image for %s interactive sessions",
+				sessionType.Name,
+			)
+		}
+		choice, err := displayer.AskChoice(
+			"Which image do you want to use?",
+			recommended,
+			cmd.InOrStdin(),
+			cmd.OutOrStdout(),
+		)
+		if err != nil {
+			return err
+		}
+		o.image = recommended[choice]
+		return nil
+	}
+
+	if o.image == "" || customAllowed || len(recommended) == 0 ||
+		slices.Contains(recommended, o.image) {
+		return nil
+	}
+	return fmt.Errorf(
+		"image '%s' is not allowed by the server, please use one of the recommended images: '%s'",
+		o.image,
+		strings.Join(recommended, "', '"),
+	)
+}
+
+// displaySessionURI prints the session URI, or copies it to the clipboard if requested.
+// When copying, only the URI without the access token is printed, even if the copy fails.
+func (o *openOptions) displaySessionURI(cmd *cobra.Command, sessionURI, publicURI string) error {
+	out := cmd.OutOrStdout()
+	if !o.copyURL {
+		displayer.PrintColorable(sessionURI+"\n", out, text.FgGreen)
+		return nil
+	}
+	displayer.PrintColorable(publicURI+"\n", out, text.FgGreen)
+	if err := copyToClipboard(sessionURI); err != nil {
+		return fmt.Errorf(
+			"the session URL could not be copied to the clipboard: %s\n"+
+				"Append ?token=<your access token> to the URL above to access the session",
+			err.Error(),
+		)
+	}
+	cmd.Println("The session URL, including your access token, was copied to the clipboard.")
+	return nil
+}
+
+// waitAndOpen waits until the interactive session is ready and opens it in the web browser.
+func (o *openOptions) waitAndOpen(
+	cmd *cobra.Command,
+	api *client.API,
+	sessionURI string,
+	timeout time.Duration,
+) error {
+	out := cmd.OutOrStdout()
+	cmd.Println("Waiting for the interactive session to be ready...")
+	if err := waitForSession(api, o.token, o.workflow, timeout); err != nil {
+		return err
+	}
+	displayer.DisplayMessage("Interactive session is ready", displayer.Success, false, out)
+
+	if err := openBrowser(sessionURI); err != nil {
+		displayer.DisplayMessage(err.Error(), displayer.Warning, false, out)
+		return nil
+	}
+	cmd.Println("The interactive session was opened in your web browser.")
+	return nil
+}
+
+// waitForSession polls the status of the interactive session of the workflow until it is ready,
+// it fails or the timeout expires.
+func waitForSession(api *client.API, token, workflow string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		status, err := getSessionStatus(api, token, workflow)
+		if err != nil {
+			return err
+		}
+		log.Debugf("Interactive session of %s is %s", workflow, status)
+		if status == config.InteractiveSessionReadyStatus {
+			return nil
+		}
+		if slices.Contains(config.InteractiveSessionFailedStatuses, status) {
+			return fmt.Errorf("interactive session of workflow %s is %s", workflow, status)
+		}
+		if !time.Now().Before(deadline) {
+			return fmt.Errorf(
+				"interactive session of workflow %s is not ready after %s, its status is %s",
+				workflow,
+				timeout,
+				status,
+			)
+		}
+		time.Sleep(time.Duration(config.CheckInterval) * time.Second)
+	}
+}
+
+// getSessionStatus returns the status of the interactive session of the workflow.
+func getSessionStatus(api *client.API, token, workflow string) (string, error) {
+	listParams := operations.NewGetWorkflowsParams()
+	listParams.SetAccessToken(&token)
+	listParams.SetType("interactive")
+	listParams.SetWorkflowIDOrName(&workflow)
+
+	listResp, err := api.Operations.GetWorkflows(listParams)
+	if err != nil {
+		return "", err
+	}
+	for _, item := range listResp.Payload.Items {
+		if item.SessionStatus != "" {
+			return item.SessionStatus, nil
+		}
+	}
+	return "", fmt.Errorf("no interactive session found for workflow %s", workflow)
+}
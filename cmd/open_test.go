@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2023 CERN.
+Copyright (C) 2022, 2023, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -9,22 +9,25 @@ under the terms of the MIT License; see LICENSE file for more details.
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/sessiontypes"
 	"strings"
 	"testing"
 )
 
 var openPathTemplate = "/api/workflows/%s/open/%s"
 var infoURL = "/api/info"
+var configServerPath = "/api/config"
 
 func TestOpen(t *testing.T) {
 	workflowName := "my_workflow"
 	tests := map[string]TestCmdParams{
 		"success default": {
 			serverResponses: map[string]ServerResponse{
-				fmt.Sprintf(openPathTemplate, workflowName, config.InteractiveSessionTypes[0]): {
+				fmt.Sprintf(openPathTemplate, workflowName, sessiontypes.DefaultType): {
 					statusCode:   http.StatusOK,
 					responseFile: "open_jupyter.json",
 				},
@@ -43,7 +46,7 @@ func TestOpen(t *testing.T) {
 		},
 		"success no autoclosure": {
 			serverResponses: map[string]ServerResponse{
-				fmt.Sprintf(openPathTemplate, workflowName, config.InteractiveSessionTypes[0]): {
+				fmt.Sprintf(openPathTemplate, workflowName, sessiontypes.DefaultType): {
 					statusCode:   http.StatusOK,
 					responseFile: "open_jupyter.json",
 				},
@@ -61,7 +64,7 @@ func TestOpen(t *testing.T) {
 		},
 		"success empty max_inactivity_time": {
 			serverResponses: map[string]ServerResponse{
-				fmt.Sprintf(openPathTemplate, workflowName, config.InteractiveSessionTypes[0]): {
+				fmt.Sprintf(openPathTemplate, workflowName, sessiontypes.DefaultType): {
 					statusCode:   http.StatusOK,
 					responseFile: "open_jupyter.json",
 				},
@@ -88,7 +91,13 @@ func TestOpen(t *testing.T) {
 					responseFile: "info_big.json",
 				},
 			},
-			args: []string{"-w", workflowName, "-i", "image", "jupyter"},
+			args: []string{
+				"-w",
+				workflowName,
+				"-i",
+				"docker.io/jupyter/scipy-notebook:notebook-6.4.5",
+				"jupyter",
+			},
 			expected: []string{
 				"Interactive session opened successfully",
 				"/test/jupyter?token=1234",
@@ -97,12 +106,68 @@ func TestOpen(t *testing.T) {
 			},
 		},
 		"invalid session type": {
+			serverResponses: map[string]ServerResponse{
+				infoURL: {
+					statusCode:   http.StatusOK,
+					responseFile: "info_small.json",
+				},
+			},
 			args: []string{"-w", workflowName, "invalid"},
 			expected: []string{
-				fmt.Sprintf(
-					"invalid value for 'interactive-session-type': 'invalid' is not part of '%s'",
-					strings.Join(config.InteractiveSessionTypes, "', '"),
-				),
+				"invalid value for 'interactive-session-type': 'invalid' is not part of 'jupyter'",
+			},
+			wantError: true,
+		},
+		"session type advertised by the server": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(openPathTemplate, workflowName, "rstudio"): {
+					statusCode:   http.StatusOK,
+					responseFile: "open_jupyter.json",
+				},
+				infoURL: {
+					statusCode:   http.StatusOK,
+					responseFile: "info_small.json",
+				},
+				configServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "config_session_types.json",
+				},
+			},
+			args: []string{"-w", workflowName, "rstudio"},
+			expected: []string{
+				"Interactive session opened successfully",
+				"Open the URL in your web browser to use RStudio.",
+			},
+		},
+		"session type not advertised by the server": {
+			serverResponses: map[string]ServerResponse{
+				infoURL: {
+					statusCode:   http.StatusOK,
+					responseFile: "info_small.json",
+				},
+			},
+			args: []string{"-w", workflowName, "rstudio"},
+			expected: []string{
+				"invalid value for 'interactive-session-type': 'rstudio' is not part of 'jupyter'",
+			},
+			wantError: true,
+		},
+		"image of session type not allowed": {
+			serverResponses: map[string]ServerResponse{
+				infoURL: {
+					statusCode:   http.StatusOK,
+					responseFile: "info_big.json",
+				},
+				configServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "config_session_types.json",
+				},
+			},
+			args: []string{
+				"-w", workflowName, "-i", "docker.io/jupyter/scipy-notebook:notebook-6.4.5", "rstudio",
+			},
+			expected: []string{
+				"image 'docker.io/jupyter/scipy-notebook:notebook-6.4.5' is not allowed by the server, please use one of the recommended images: 'docker.io/rocker/rstudio:4.4', 'docker.io/rocker/tidyverse:4.4'",
 			},
 			wantError: true,
 		},
@@ -112,6 +177,10 @@ func TestOpen(t *testing.T) {
 					statusCode:   http.StatusNotFound,
 					responseFile: "open_already_open.json",
 				},
+				infoURL: {
+					statusCode:   http.StatusOK,
+					responseFile: "info_small.json",
+				},
 			},
 			args:      []string{"-w", workflowName},
 			expected:  []string{"Interactive session is already open"},
@@ -126,3 +195,217 @@ func TestOpen(t *testing.T) {
 		})
 	}
 }
+
+func TestOpenSessionLifecycle(t *testing.T) {
+	// Deactivate the sleep used with the --wait flag
+	oldInterval := config.CheckInterval
+	config.CheckInterval = 0
+	oldOpenBrowser, oldCopyToClipboard := openBrowser, copyToClipboard
+	t.Cleanup(func() {
+		config.CheckInterval = oldInterval
+		openBrowser, copyToClipboard = oldOpenBrowser, oldCopyToClipboard
+	})
+
+	var openedURL, copiedURL string
+	var desktopErr error
+	openBrowser = func(url string) error {
+		openedURL = url
+		return desktopErr
+	}
+	copyToClipboard = func(text string) error {
+		copiedURL = text
+		return desktopErr
+	}
+
+	workflowName := "my_workflow"
+	openPath := fmt.Sprintf(openPathTemplate, workflowName, "jupyter")
+	tests := map[string]struct {
+		params     TestCmdParams
+		desktopErr error
+		opened     string
+		copied     string
+	}{
+		"wait until ready": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					infoURL:  {statusCode: http.StatusOK, responseFile: "info_small.json"},
+					openPath: {statusCode: http.StatusOK, responseFile: "open_jupyter.json"},
+					// The calls are counted across paths: info, open, then the status checks.
+					listServerPath: {
+						statusCode:   http.StatusOK,
+						responseFile: "open_session_running.json",
+						additionalResponseFiles: []string{
+							"open_session_created.json",
+							"open_session_created.json",
+						},
+					},
+				},
+				args: []string{"-w", workflowName, "--wait"},
+				expected: []string{
+					"Waiting for the interactive session to be ready...",
+					"Interactive session is ready",
+					"The interactive session was opened in your web browser.",
+				},
+				unwanted: []string{"It could take several minutes"},
+			},
+			opened: "/test/jupyter?token=1234",
+		},
+		"wait failed session": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					infoURL:  {statusCode: http.StatusOK, responseFile: "info_small.json"},
+					openPath: {statusCode: http.StatusOK, responseFile: "open_jupyter.json"},
+					listServerPath: {
+						statusCode:   http.StatusOK,
+						responseFile: "open_session_failed.json",
+					},
+				},
+				args:      []string{"-w", workflowName, "--wait"},
+				expected:  []string{"interactive session of workflow my_workflow is failed"},
+				wantError: true,
+			},
+		},
+		"wait timeout": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					infoURL:  {statusCode: http.StatusOK, responseFile: "info_small.json"},
+					openPath: {statusCode: http.StatusOK, responseFile: "open_jupyter.json"},
+					listServerPath: {
+						statusCode:   http.StatusOK,
+						responseFile: "open_session_created.json",
+					},
+				},
+				args: []string{"-w", workflowName, "--wait", "--wait-timeout", "0s"},
+				expected: []string{
+					"interactive session of workflow my_workflow is not ready after 0s, its status is created",
+				},
+				wantError: true,
+			},
+		},
+		"browser not available": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					infoURL:  {statusCode: http.StatusOK, responseFile: "info_small.json"},
+					openPath: {statusCode: http.StatusOK, responseFile: "open_jupyter.json"},
+					listServerPath: {
+						statusCode:   http.StatusOK,
+						responseFile: "open_session_running.json",
+					},
+				},
+				args:     []string{"-w", workflowName, "--wait"},
+				expected: []string{"Interactive session is ready", "no browser", "/test/jupyter?token=1234"},
+				unwanted: []string{"opened in your web browser"},
+			},
+			desktopErr: errors.New("no browser"),
+			opened:     "/test/jupyter?token=1234",
+		},
+		"invalid wait timeout": {
+			params: TestCmdParams{
+				args:      []string{"-w", workflowName, "--wait-timeout", "soon"},
+				expected:  []string{"invalid value for '--wait-timeout'"},
+				wantError: true,
+			},
+		},
+		"copy url": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					infoURL:  {statusCode: http.StatusOK, responseFile: "info_small.json"},
+					openPath: {statusCode: http.StatusOK, responseFile: "open_jupyter.json"},
+				},
+				args: []string{"-w", workflowName, "--copy-url"},
+				expected: []string{
+					"/test/jupyter",
+					"The session URL, including your access token, was copied to the clipboard.",
+				},
+				unwanted: []string{"token=1234"},
+			},
+			copied: "/test/jupyter?token=1234",
+		},
+		"copy url not available": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					infoURL:  {statusCode: http.StatusOK, responseFile: "info_small.json"},
+					openPath: {statusCode: http.StatusOK, responseFile: "open_jupyter.json"},
+				},
+				args: []string{"-w", workflowName, "--copy-url"},
+				expected: []string{
+					"/test/jupyter",
+					"the session URL could not be copied to the clipboard: no clipboard",
+				},
+				unwanted:  []string{"token=1234", "was copied to the clipboard"},
+				wantError: true,
+			},
+			desktopErr: errors.New("no clipboard"),
+			copied:     "/test/jupyter?token=1234",
+		},
+		"image not allowed": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					infoURL: {statusCode: http.StatusOK, responseFile: "info_big.json"},
+				},
+				args: []string{"-w", workflowName, "-i", "my/image"},
+				expected: []string{
+					"image 'my/image' is not allowed by the server, please use one of the recommended images: 'docker.io/jupyter/scipy-notebook:notebook-6.4.5'",
+				},
+				wantError: true,
+			},
+		},
+		"recommended image": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					infoURL:  {statusCode: http.StatusOK, responseFile: "info_big.json"},
+					openPath: {statusCode: http.StatusOK, responseFile: "open_jupyter.json"},
+				},
+				args: []string{
+					"-w", workflowName, "-i", "docker.io/jupyter/scipy-notebook:notebook-6.4.5",
+				},
+				expected: []string{"Interactive session opened successfully"},
+			},
+		},
+		"pick image": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					infoURL:  {statusCode: http.StatusOK, responseFile: "info_big.json"},
+					openPath: {statusCode: http.StatusOK, responseFile: "open_jupyter.json"},
+				},
+				args: []string{"-w", workflowName, "--pick-image"},
+				expected: []string{
+					"[1] docker.io/jupyter/scipy-notebook:notebook-6.4.5",
+					"Interactive session opened successfully",
+				},
+			},
+		},
+		"pick image without recommendations": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					infoURL: {statusCode: http.StatusOK, responseFile: "info_small.json"},
+				},
+				args:      []string{"-w", workflowName, "--pick-image"},
+				expected:  []string{"the server does not recommend any image for jupyter interactive sessions"},
+				wantError: true,
+			},
+		},
+		"image and pick image": {
+			params: TestCmdParams{
+				args:      []string{"-w", workflowName, "-i", "image", "--pick-image"},
+				expected:  []string{"please provide either --image or --pick-image, not both"},
+				wantError: true,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			openedURL, copiedURL, desktopErr = "", "", test.desktopErr
+			test.params.cmd = "open"
+			testCmdRun(t, test.params)
+
+			if !strings.HasSuffix(openedURL, test.opened) || (test.opened == "") != (openedURL == "") {
+				t.Errorf("Expected browser to open '%s', got '%s'", test.opened, openedURL)
+			}
+			if !strings.HasSuffix(copiedURL, test.copied) || (test.copied == "") != (copiedURL == "") {
+				t.Errorf("Expected clipboard to contain '%s', got '%s'", test.copied, copiedURL)
+			}
+		})
+	}
+}
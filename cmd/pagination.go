@@ -0,0 +1,115 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"io"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/formatter"
+	"reanahub/reana-client-go/pkg/paginator"
+
+	"github.com/go-gota/gota/dataframe"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// paginationOptions contains the values of the --limit and --all-pages flags.
+type paginationOptions struct {
+	limit    int64
+	allPages bool
+}
+
+// addPaginationFlags adds the --limit and --all-pages flags, which complete the --page and --size flags.
+func addPaginationFlags(f *pflag.FlagSet, o *paginationOptions) {
+	f.Int64Var(
+		&o.limit,
+		"limit",
+		0,
+		"Maximum number of results to show. All of them by default.",
+	)
+	f.BoolVar(
+		&o.allPages,
+		"all-pages",
+		false,
+		`Retrieve all the pages of results, starting from --page,
+with --size results per request.`,
+	)
+}
+
+// paginatorOptions returns the pages to retrieve. By default, all the pages are retrieved with
+// defaultSize results per request. If --page or --size is given, only the requested page is
+// retrieved, unless --all-pages is given as well.
+func (o *paginationOptions) paginatorOptions(
+	cmd *cobra.Command,
+	page, size, defaultSize int64,
+) (paginator.Options, error) {
+	if o.limit < 0 {
+		return paginator.Options{}, errors.New(
+			"invalid value for '--limit': it must be a positive number",
+		)
+	}
+	options := paginator.Options{
+		Page:     1,
+		Size:     defaultSize,
+		Limit:    o.limit,
+		AllPages: true,
+	}
+	if cmd.Flags().Changed("page") || cmd.Flags().Changed("size") {
+		options.Page = page
+		options.AllPages = o.allPages
+	}
+	if cmd.Flags().Changed("size") {
+		options.Size = size
+	}
+	return options, nil
+}
+
+// dataFrameStream displays data frames one after the other, e.g. one per page of results, as a single
+// table or JSON array.
+type dataFrameStream struct {
+	out   io.Writer
+	table *displayer.TableStream[string]
+	json  *displayer.JsonStream
+}
+
+// newDataFrameStream creates a stream displaying data frames in a table, or in JSON format if jsonOutput is true.
+func newDataFrameStream(out io.Writer, jsonOutput bool) *dataFrameStream {
+	stream := &dataFrameStream{out: out}
+	if jsonOutput {
+		stream.json = displayer.NewJsonStream(out)
+	}
+	return stream
+}
+
+// append displays the rows of the data frame. The columns of the table are those of the first data frame.
+func (s *dataFrameStream) append(df dataframe.DataFrame) error {
+	if s.json != nil {
+		for _, row := range df.Maps() {
+			if err := s.json.Write(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if s.table == nil {
+		s.table = displayer.NewTableStream[string](df.Names(), s.out)
+	}
+	s.table.Append(formatter.DataFrameToStringData(df))
+	return nil
+}
+
+// close ends the display of the data frames.
+func (s *dataFrameStream) close() error {
+	if s.json != nil {
+		return s.json.Close()
+	}
+	return nil
+}
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -12,7 +12,6 @@ import (
 	"fmt"
 
 	"reanahub/reana-client-go/client"
-	"reanahub/reana-client-go/client/operations"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -57,21 +56,17 @@ func newPingCmd() *cobra.Command {
 }
 
 func (o *pingOptions) run(cmd *cobra.Command) error {
-	pingParams := operations.NewGetYouParams()
-	pingParams.SetAccessToken(&o.token)
-
 	api, err := client.ApiClient()
 	if err != nil {
 		return err
 	}
-	pingResp, err := api.Operations.GetYou(pingParams)
+	p, err := getPingInfo(api, o.token, true)
 	if err != nil {
 		return err
 	}
 
-	p := pingResp.Payload
 	response := fmt.Sprintf("REANA server: %s \n", o.serverURL) +
-		fmt.Sprintf("REANA server version: %s \n", p.ReanaServerVersion) +
+		fmt.Sprintf("REANA server version: %s \n", p.ServerVersion) +
 		fmt.Sprintf("REANA client version: %s \n", version) +
 		fmt.Sprintf("Authenticated as: <%s> \n", p.Email) +
 		fmt.Sprintf("Status: %s ", "Connected")
@@ -0,0 +1,344 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/quotahistory"
+	"reanahub/reana-client-go/pkg/validator"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const quotaHistoryDesc = `
+Show the quota usage history and forecast.
+
+The ` + "``quota-history``" + ` command records a snapshot of your quota usage in a
+local history file each time it runs, and displays how the usage of a resource
+evolved over time. Based on the usage trend, it projects when the limit will be
+reached. For resources with a quota period, such as CPU, only the snapshots of
+the current period are taken into account.
+
+Use ` + "``--record``" + ` to only record a snapshot without displaying anything, for
+example from a cron job, to collect the usage regularly.
+
+Examples:
+
+	$ reana-client quota-history --resource cpu
+
+	$ reana-client quota-history --resource disk -h --last 30
+
+	$ reana-client quota-history --record
+`
+
+type quotaHistoryOptions struct {
+	token       string
+	resource    string
+	record      bool
+	historyFile string
+	last        int
+	sizeOptions
+}
+
+// newQuotaHistoryCmd creates a command to show the quota usage history and forecast.
+func newQuotaHistoryCmd() *cobra.Command {
+	o := &quotaHistoryOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "quota-history",
+		Short: "Show the quota usage history and forecast.",
+		Long:  quotaHistoryDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validator.ValidateAtLeastOne(
+				cmd.Flags(), []string{"resource", "record"},
+			); err != nil {
+				return fmt.Errorf("%s\n%s", err.Error(), cmd.UsageString())
+			}
+			if err := o.validateSizeFlags(); err != nil {
+				return err
+			}
+			if o.last < 1 {
+				return fmt.Errorf("invalid value for '--last': must be a positive number")
+			}
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(
+		&o.token,
+		"access-token",
+		"t",
+		"",
+		"Access token of the current user.",
+	)
+	f.StringVarP(
+		&o.resource,
+		"resource",
+		"",
+		"",
+		"Specify quota resource. e.g. cpu, disk.",
+	)
+	f.BoolVar(
+		&o.record,
+		"record",
+		false,
+		"Only record a snapshot of the quota usage, without displaying it.",
+	)
+	f.StringVar(
+		&o.historyFile,
+		"history-file",
+		"",
+		`Local file where the snapshots are stored. By default,
+a file per server in the user configuration directory.`,
+	)
+	f.IntVar(
+		&o.last,
+		"last",
+		20,
+		"Number of most recent snapshots to display.",
+	)
+	addSizeFlags(f, &o.sizeOptions, "Show usage and limit in human readable format.", false)
+	// Remove -h shorthand
+	cmd.PersistentFlags().BoolP("help", "", false, "Help for quota-history")
+
+	return cmd
+}
+
+func (o *quotaHistoryOptions) run(cmd *cobra.Command) error {
+	historyFile := o.historyFile
+	if historyFile == "" {
+		var err error
+		historyFile, err = quotahistory.DefaultPath(viper.GetString("server-url"))
+		if err != nil {
+			return err
+		}
+	}
+
+	quotaParams := operations.NewGetYouParams()
+	quotaParams.SetAccessToken(&o.token)
+
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	quotaResp, err := api.Operations.GetYou(quotaParams)
+	if err != nil {
+		return err
+	}
+	quotaResources, err := parseQuotaInfo(quotaResp.Payload.Quota)
+	if err != nil {
+		return err
+	}
+
+	snapshot := quotahistory.Snapshot{
+		Timestamp: time.Now().UTC().Truncate(time.Second),
+		Resources: map[string]quotahistory.ResourceSnapshot{},
+	}
+	for name, resource := range quotaResources {
+		usage, limit := resource.Stats["usage"], resource.Stats["limit"]
+		snapshot.Resources[name] = quotahistory.ResourceSnapshot{
+			Usage:              usage.Raw,
+			Limit:              limit.Raw,
+			UsageHumanReadable: usage.HumanReadable,
+			LimitHumanReadable: limit.HumanReadable,
+			Health:             resource.Health,
+		}
+	}
+	if err := quotahistory.Append(historyFile, snapshot); err != nil {
+		return fmt.Errorf(
+			"history file %s could not be written: %s",
+			historyFile, err.Error(),
+		)
+	}
+	if o.record {
+		return nil
+	}
+
+	resource, isValidResource := quotaResources[o.resource]
+	if !isValidResource {
+		var availableResources []string
+		for resourceName := range quotaResources {
+			availableResources = append(availableResources, resourceName)
+		}
+		return fmt.Errorf(
+			"resource '%s' is not valid\nAvailable resources are '%s'",
+			o.resource,
+			strings.Join(availableResources, "', '"),
+		)
+	}
+
+	snapshots, err := quotahistory.Load(historyFile)
+	if err != nil {
+		return err
+	}
+
+	// Usage is reset at the beginning of each quota period, so only the current period is relevant.
+	var periodStart time.Time
+	startDate, endDate := getQuotaPeriodDateRange(resource)
+	if startDate != "" {
+		periodStart, err = time.Parse("2006-01-02", startDate)
+		if err != nil {
+			return err
+		}
+	}
+
+	out := cmd.OutOrStdout()
+	o.displayHistory(snapshots, periodStart, out)
+
+	points := quotahistory.Series(snapshots, o.resource, periodStart)
+	displayQuotaForecast(
+		o.resource,
+		points,
+		resource.Stats["usage"].Raw,
+		resource.Stats["limit"].Raw,
+		endDate,
+		snapshot.Timestamp,
+		out,
+	)
+	return nil
+}
+
+// displayHistory displays a table and a sparkline with the most recent snapshots of the resource.
+func (o *quotaHistoryOptions) displayHistory(
+	snapshots []quotahistory.Snapshot,
+	since time.Time,
+	out io.Writer,
+) {
+	var rows [][]string
+	var values []float64
+	var limit float64
+	for _, snapshot := range snapshots {
+		stat, ok := snapshot.Resources[o.resource]
+		if !ok || snapshot.Timestamp.Before(since) {
+			continue
+		}
+		usage := fmt.Sprintf("%.0f", stat.Usage)
+		limitValue := fmt.Sprintf("%.0f", stat.Limit)
+		if o.showHumanReadable() {
+			usage, limitValue = stat.UsageHumanReadable, stat.LimitHumanReadable
+			if o.resource == "disk" {
+				usage = o.formatSize(int64(stat.Usage))
+				limitValue = o.formatSize(int64(stat.Limit))
+			}
+		}
+		percentage := "-"
+		if stat.Limit > 0 {
+			percentage = fmt.Sprintf("%.0f%%", stat.Usage/stat.Limit*100)
+		} else {
+			limitValue = "-"
+		}
+		rows = append(rows, []string{
+			snapshot.Timestamp.Format("2006-01-02T15:04:05"),
+			usage,
+			limitValue,
+			percentage,
+		})
+		values = append(values, stat.Usage)
+		limit = stat.Limit
+	}
+	if len(rows) > o.last {
+		rows = rows[len(rows)-o.last:]
+		values = values[len(values)-o.last:]
+	}
+
+	displayer.DisplayTable(
+		[]string{"timestamp", "usage", "limit", "percentage"},
+		rows,
+		out,
+	)
+	fmt.Fprintf(out, "\n%s usage: %s\n\n", o.resource, displayer.Sparkline(values, limit))
+}
+
+// displayQuotaForecast displays when the limit of the resource is expected to be reached,
+// according to the usage trend of the given points.
+func displayQuotaForecast(
+	resource string,
+	points []quotahistory.Point,
+	usage, limit float64,
+	periodEndDate string,
+	now time.Time,
+	out io.Writer,
+) {
+	if limit <= 0 {
+		displayer.DisplayMessage(
+			fmt.Sprintf("There is no %s limit, nothing to forecast.", resource),
+			displayer.Info,
+			false,
+			out,
+		)
+		return
+	}
+	if usage >= limit {
+		displayer.DisplayMessage(
+			fmt.Sprintf("The %s limit has already been reached.", resource),
+			displayer.Error,
+			false,
+			out,
+		)
+		return
+	}
+	if len(points) < 2 {
+		displayer.DisplayMessage(
+			"Not enough snapshots to forecast the usage. "+
+				"Record them regularly with --record, e.g. from a cron job.",
+			displayer.Info,
+			false,
+			out,
+		)
+		return
+	}
+
+	reachedAt, reached := quotahistory.ForecastLimit(points, limit, now)
+	if !reached {
+		displayer.DisplayMessage(
+			fmt.Sprintf(
+				"At the current rate, the %s limit is not expected to be reached.",
+				resource,
+			),
+			displayer.Info,
+			false,
+			out,
+		)
+		return
+	}
+
+	reachedDate := reachedAt.Format("2006-01-02")
+	if periodEndDate != "" && reachedDate >= periodEndDate {
+		displayer.DisplayMessage(
+			fmt.Sprintf(
+				"At the current rate, the %s limit will not be reached before the end of the quota period on %s.",
+				resource,
+				periodEndDate,
+			),
+			displayer.Info,
+			false,
+			out,
+		)
+		return
+	}
+	displayer.DisplayMessage(
+		fmt.Sprintf(
+			"At the current rate, the %s limit will be reached on %s.",
+			resource,
+			reachedDate,
+		),
+		displayer.Warning,
+		false,
+		out,
+	)
+}
@@ -0,0 +1,179 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestQuotaHistory(t *testing.T) {
+	tests := map[string]struct {
+		history string
+		params  TestCmdParams
+	}{
+		"missing resource": {
+			params: TestCmdParams{
+				args:      []string{},
+				wantError: true,
+				expected: []string{
+					"at least one of the options: 'resource', 'record' is required",
+				},
+			},
+		},
+		"invalid last": {
+			params: TestCmdParams{
+				args:      []string{"--resource", "disk", "--last", "0"},
+				wantError: true,
+				expected:  []string{"invalid value for '--last'"},
+			},
+		},
+		"invalid resource": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					quotaShowServerPath: {
+						statusCode:   http.StatusOK,
+						responseFile: "quota_show_complete.json",
+					},
+				},
+				args:      []string{"--resource", "memory"},
+				wantError: true,
+				expected:  []string{"resource 'memory' is not valid"},
+			},
+		},
+		"record only": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					quotaShowServerPath: {
+						statusCode:   http.StatusOK,
+						responseFile: "quota_show_complete.json",
+					},
+				},
+				args:     []string{"--record"},
+				unwanted: []string{"TIMESTAMP", "USAGE"},
+			},
+		},
+		"not enough snapshots": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					quotaShowServerPath: {
+						statusCode:   http.StatusOK,
+						responseFile: "quota_show_complete.json",
+					},
+				},
+				args: []string{"--resource", "disk"},
+				expected: []string{
+					"TIMESTAMP", "USAGE", "LIMIT", "PERCENTAGE",
+					"10%", "disk usage: ",
+					"Not enough snapshots to forecast the usage.",
+				},
+			},
+		},
+		"increasing usage": {
+			history: `{"timestamp":"2026-01-01T00:00:00Z","resources":{"disk":{"usage":5,"limit":200,"usage_human_readable":"5 Bytes","limit_human_readable":"200 Bytes"}}}
+{"timestamp":"2026-02-01T00:00:00Z","resources":{"disk":{"usage":10,"limit":200,"usage_human_readable":"10 Bytes","limit_human_readable":"200 Bytes"}}}
+`,
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					quotaShowServerPath: {
+						statusCode:   http.StatusOK,
+						responseFile: "quota_show_complete.json",
+					},
+				},
+				args: []string{"--resource", "disk", "-h"},
+				expected: []string{
+					"2026-01-01T00:00:00", "2026-02-01T00:00:00",
+					"5 Bytes", "200 Bytes", "20 Bytes",
+					"At the current rate, the disk limit will be reached on",
+				},
+			},
+		},
+		"last snapshots": {
+			history: `{"timestamp":"2026-01-01T00:00:00Z","resources":{"disk":{"usage":5,"limit":200}}}
+{"timestamp":"2026-02-01T00:00:00Z","resources":{"disk":{"usage":10,"limit":200}}}
+`,
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					quotaShowServerPath: {
+						statusCode:   http.StatusOK,
+						responseFile: "quota_show_complete.json",
+					},
+				},
+				args:     []string{"--resource", "disk", "--last", "2"},
+				expected: []string{"2026-02-01T00:00:00"},
+				unwanted: []string{"2026-01-01T00:00:00"},
+			},
+		},
+		"decreasing usage": {
+			history: `{"timestamp":"2026-01-01T00:00:00Z","resources":{"disk":{"usage":150,"limit":200}}}
+{"timestamp":"2026-02-01T00:00:00Z","resources":{"disk":{"usage":100,"limit":200}}}
+`,
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					quotaShowServerPath: {
+						statusCode:   http.StatusOK,
+						responseFile: "quota_show_complete.json",
+					},
+				},
+				args: []string{"--resource", "disk"},
+				expected: []string{
+					"75%", "50%",
+					"At the current rate, the disk limit is not expected to be reached.",
+				},
+			},
+		},
+		"quota period": {
+			history: `{"timestamp":"2026-05-01T00:00:00Z","resources":{"cpu":{"usage":90,"limit":100}}}
+{"timestamp":"2026-06-10T00:00:00Z","resources":{"cpu":{"usage":1,"limit":100}}}
+{"timestamp":"2026-07-10T00:00:00Z","resources":{"cpu":{"usage":5,"limit":100}}}
+`,
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					quotaShowServerPath: {
+						statusCode:   http.StatusOK,
+						responseFile: "quota_show_complete.json",
+					},
+				},
+				args: []string{"--resource", "cpu"},
+				expected: []string{
+					"2026-06-10T00:00:00", "2026-07-10T00:00:00",
+					"the cpu limit will not be reached before the end of the quota period on 2026-09-04",
+				},
+				unwanted: []string{"2026-05-01T00:00:00", "90%"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			historyFile := filepath.Join(t.TempDir(), "history.jsonl")
+			if test.history != "" {
+				if err := os.WriteFile(historyFile, []byte(test.history), 0o644); err != nil {
+					t.Fatalf("Error while writing history file: %v", err)
+				}
+			}
+			test.params.cmd = "quota-history"
+			test.params.args = append(test.params.args, "--history-file", historyFile)
+			testCmdRun(t, test.params)
+
+			if name == "record only" {
+				data, err := os.ReadFile(historyFile)
+				if err != nil {
+					t.Fatalf("Expected history file to be created, got error: %v", err)
+				}
+				if lines := strings.Count(string(data), "\n"); lines != 1 {
+					t.Errorf("Expected one snapshot in history file, got %d", lines)
+				}
+			}
+		})
+	}
+}
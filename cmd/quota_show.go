@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -15,6 +15,7 @@ import (
 	"reanahub/reana-client-go/client"
 	"reanahub/reana-client-go/client/operations"
 	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/datautils"
 	"reanahub/reana-client-go/pkg/displayer"
 	"reanahub/reana-client-go/pkg/validator"
 	"strings"
@@ -61,8 +62,8 @@ type quotaShowOptions struct {
 	report            string
 	resource          string
 	showResources     bool
-	humanReadable     bool
 	unspecifiedReport bool
+	sizeOptions
 }
 
 type quotaPeriodInfo struct {
@@ -86,6 +87,9 @@ func newQuotaShowCmd() *cobra.Command {
 			); err != nil {
 				return fmt.Errorf("%s\n%s", err.Error(), cmd.UsageString())
 			}
+			if err := o.validateSizeFlags(); err != nil {
+				return err
+			}
 			if cmd.Flags().Changed("report") {
 				if err := validator.ValidateChoice(
 					o.report, config.QuotaReports, "report",
@@ -128,13 +132,7 @@ func newQuotaShowCmd() *cobra.Command {
 		false,
 		"Print available resources.",
 	)
-	f.BoolVarP(
-		&o.humanReadable,
-		"human-readable",
-		"h",
-		false,
-		"Show disk size in human readable format.",
-	)
+	addSizeFlags(f, &o.sizeOptions, "Show disk size in human readable format.", false)
 	// Remove -h shorthand
 	cmd.PersistentFlags().BoolP("help", "", false, "Help for quota-show")
 
@@ -177,17 +175,18 @@ func (o *quotaShowOptions) run(cmd *cobra.Command) error {
 		)
 	}
 
+	resource.Stats = formatQuotaStats(o.resource, resource.Stats, o.units)
 	report, reportExists := resource.Stats[o.report]
 	if o.unspecifiedReport {
 		displayQuotaResourceUsage(
 			resource.Health,
 			resource.Stats["usage"], resource.Stats["limit"],
 			formatQuotaPeriodWindow(resource),
-			o.humanReadable, cmd.OutOrStdout(),
+			o.showHumanReadable(), cmd.OutOrStdout(),
 		)
 	} else if !reportExists || report.Raw <= 0 {
 		cmd.Printf("No %s.\n", o.report)
-	} else if o.humanReadable {
+	} else if o.showHumanReadable() {
 		msg := report.HumanReadable
 		if o.resource == "cpu" {
 			if window := formatQuotaPeriodWindow(resource); window != "" {
@@ -202,6 +201,24 @@ func (o *quotaShowOptions) run(cmd *cobra.Command) error {
 	return nil
 }
 
+// formatQuotaStats returns the stats of the resource, with the human readable disk sizes formatted in the
+// given units. The human readable values of the other resources, such as the CPU time, are the server ones.
+func formatQuotaStats(
+	resourceName string,
+	stats map[string]quotaResourceStat,
+	units string,
+) map[string]quotaResourceStat {
+	if resourceName != "disk" {
+		return stats
+	}
+	formatted := make(map[string]quotaResourceStat, len(stats))
+	for name, stat := range stats {
+		stat.HumanReadable = datautils.FormatByteSizeUnits(int64(stat.Raw), units)
+		formatted[name] = stat
+	}
+	return formatted
+}
+
 // NOTE: Keep this month-boundary arithmetic in sync with
 // reana_db/utils.py (_add_months), reana-client's _add_months helper,
 // and reana-ui's quota period window calculation.
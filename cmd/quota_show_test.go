@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -194,8 +194,8 @@ func TestQuotaShow(t *testing.T) {
 				},
 			},
 			args:     []string{"--resource", "disk", "--report", "limit", "-h"},
-			expected: []string{"20 MiB"},
-			unwanted: []string{"used", "limit", "usage", "cpu", "disk", "200"},
+			expected: []string{"200 Bytes"},
+			unwanted: []string{"used", "limit", "usage", "cpu", "disk", "MiB"},
 		},
 		"disk usage human": {
 			serverResponses: map[string]ServerResponse{
@@ -205,17 +205,22 @@ func TestQuotaShow(t *testing.T) {
 				},
 			},
 			args:     []string{"--resource", "disk", "--report", "usage", "-h"},
-			expected: []string{"2 MiB"},
+			expected: []string{"20 Bytes"},
 			unwanted: []string{
 				"used",
 				"limit",
 				"usage",
 				"cpu",
 				"disk",
-				"20",
+				"MiB",
 				"in the period from",
 			},
 		},
+		"disk human and bytes": {
+			args:      []string{"--resource", "disk", "-h", "--bytes"},
+			expected:  []string{"please provide either --human-readable or --bytes, not both"},
+			wantError: true,
+		},
 		"disk all reports": {
 			serverResponses: map[string]ServerResponse{
 				quotaShowServerPath: {
@@ -0,0 +1,365 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/datautils"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/validator"
+	"reanahub/reana-client-go/pkg/workflows"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const reportDesc = `
+Report resource usage of your workflows.
+
+The ` + "``report usage``" + ` command aggregates, across all the runs of your
+workflows, the total wall time, the number of jobs, the failure rate and the
+workspace disk usage. Runs are grouped by workflow name by default, and can be
+restricted to the ones created in a given time range with ` + "``--since``" + ` and
+` + "``--until``" + `, which accept dates (e.g. 2026-01-01) or durations (e.g. 30d).
+
+The failure rate is the ratio of failed runs among the runs that are finished,
+failed or stopped.
+
+Examples:
+
+  $ reana-client report usage --since 2026-01-01
+
+  $ reana-client report usage --since 30d --group-by status -h
+
+  $ reana-client report usage --since 2026-01-01 --until 2026-02-01 --csv
+`
+
+type reportOptions struct {
+	token      string
+	since      string
+	until      string
+	groupBy    string
+	jsonOutput bool
+	csvOutput  bool
+	sizeOptions
+}
+
+// usageReportRow aggregated resource usage of a group of workflow runs.
+type usageReportRow struct {
+	Group         string   `json:"group"`
+	Runs          int      `json:"runs"`
+	Finished      int      `json:"finished"`
+	Failed        int      `json:"failed"`
+	FailureRate   *float64 `json:"failure_rate"`
+	Duration      int64    `json:"duration"`
+	Jobs          int64    `json:"jobs"`
+	FailedJobs    int64    `json:"failed_jobs"`
+	WorkspaceSize int64    `json:"workspace_size"`
+
+	completed int
+}
+
+// newReportCmd creates a command to report the resource usage of the workflows.
+func newReportCmd() *cobra.Command {
+	o := &reportOptions{}
+
+	cmd := &cobra.Command{
+		Use:       "report usage",
+		Short:     "Report resource usage of your workflows.",
+		Long:      reportDesc,
+		ValidArgs: config.ReportTypes,
+		Args: cobra.MatchAll(
+			cobra.ExactArgs(1),
+			cobra.OnlyValidArgs,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if o.jsonOutput && o.csvOutput {
+				return errors.New("please provide either --json or --csv, not both")
+			}
+			if err := o.validateSizeFlags(); err != nil {
+				return err
+			}
+			if err := validator.ValidateChoice(
+				o.groupBy, config.ReportGroupByColumns, "group-by",
+			); err != nil {
+				return err
+			}
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(
+		&o.token,
+		"access-token",
+		"t",
+		"",
+		"Access token of the current user.",
+	)
+	f.StringVar(
+		&o.since,
+		"since",
+		"",
+		`Only include the runs created at or after the given date
+(e.g. 2026-01-01) or duration ago (e.g. 30d).`,
+	)
+	f.StringVar(
+		&o.until,
+		"until",
+		"",
+		`Only include the runs created before the given date
+(e.g. 2026-02-01) or duration ago (e.g. 7d).`,
+	)
+	f.StringVar(
+		&o.groupBy,
+		"group-by",
+		"name",
+		"Group the runs by name, status or month of creation.",
+	)
+	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
+	f.BoolVar(&o.csvOutput, "csv", false, "Get output in CSV format.")
+	addSizeFlags(
+		f,
+		&o.sizeOptions,
+		"Show duration and disk size in human readable format.",
+		false,
+	)
+	// Remove -h shorthand
+	cmd.PersistentFlags().BoolP("help", "", false, "Help for report")
+
+	return cmd
+}
+
+func (o *reportOptions) run(cmd *cobra.Command) error {
+	since, err := parseReportTime(o.since, "since")
+	if err != nil {
+		return err
+	}
+	until, err := parseReportTime(o.until, "until")
+	if err != nil {
+		return err
+	}
+
+	verbose, includeProgress, includeWorkspaceSize := true, true, true
+	listParams := operations.NewGetWorkflowsParams()
+	listParams.SetAccessToken(&o.token)
+	listParams.SetType("batch")
+	listParams.SetVerbose(&verbose)
+	listParams.SetIncludeProgress(&includeProgress)
+	listParams.SetIncludeWorkspaceSize(&includeWorkspaceSize)
+	listParams.SetStatus(config.GetRunStatuses(true))
+
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	listResp, err := api.Operations.GetWorkflows(listParams)
+	if err != nil {
+		return err
+	}
+
+	rows, err := buildUsageReport(listResp.Payload.Items, o.groupBy, since, until)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if o.jsonOutput {
+		return displayer.DisplayJsonOutput(rows, out)
+	}
+	if o.csvOutput {
+		return displayUsageReportCSV(rows, o.groupBy, out)
+	}
+	if len(rows) == 0 {
+		displayer.DisplayMessage(
+			"No workflow runs found in the given time range.",
+			displayer.Info,
+			false,
+			out,
+		)
+		return nil
+	}
+	header, data := usageReportData(rows, o.groupBy, o.sizeOptions)
+	displayer.DisplayTable(header, data, out)
+	return nil
+}
+
+// parseReportTime parses a date in the format YYYY-MM-DD or a duration ago, such as 30d.
+// An empty value returns the zero time.
+func parseReportTime(value, flag string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if date, err := time.Parse("2006-01-02", value); err == nil {
+		return date, nil
+	}
+	duration, err := datautils.ParseDuration(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(
+			"invalid value for '--%s': expected a date (YYYY-MM-DD) or a duration (e.g. 30d)",
+			flag,
+		)
+	}
+	return time.Now().UTC().Add(-duration), nil
+}
+
+// buildUsageReport aggregates the resource usage of the workflow runs created between since and until,
+// grouped by the given column. Zero times are not taken into account. Rows are sorted by group.
+func buildUsageReport(
+	items []*operations.GetWorkflowsOKBodyItemsItems0,
+	groupBy string,
+	since, until time.Time,
+) ([]usageReportRow, error) {
+	groups := make(map[string]*usageReportRow)
+	for _, workflow := range items {
+		created, err := datautils.FromIsoToTimestamp(workflow.Created)
+		if err != nil {
+			return nil, err
+		}
+		if (!since.IsZero() && created.Before(since)) ||
+			(!until.IsZero() && !created.Before(until)) {
+			continue
+		}
+
+		var group string
+		switch groupBy {
+		case "name":
+			group, _ = workflows.GetNameAndRunNumber(workflow.Name)
+		case "status":
+			group = workflow.Status
+		case "month":
+			group = created.Format("2006-01")
+		}
+		row, exists := groups[group]
+		if !exists {
+			row = &usageReportRow{Group: group}
+			groups[group] = row
+		}
+
+		row.Runs++
+		switch workflow.Status {
+		case "finished":
+			row.Finished++
+			row.completed++
+		case "failed":
+			row.Failed++
+			row.completed++
+		case "stopped":
+			row.completed++
+		}
+		if workflow.Size != nil && workflow.Size.Raw > 0 {
+			row.WorkspaceSize += workflow.Size.Raw
+		}
+
+		progress := workflow.Progress
+		if progress == nil {
+			continue
+		}
+		duration, err := workflows.GetDuration(
+			progress.RunStartedAt,
+			progress.RunFinishedAt,
+			progress.RunStoppedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if seconds, ok := duration.(float64); ok {
+			row.Duration += int64(seconds)
+		}
+		if progress.Total != nil {
+			row.Jobs += progress.Total.Total
+		}
+		if progress.Failed != nil {
+			row.FailedJobs += progress.Failed.Total
+		}
+	}
+
+	rows := make([]usageReportRow, 0, len(groups))
+	for _, row := range groups {
+		if row.completed > 0 {
+			failureRate := float64(row.Failed) / float64(row.completed)
+			row.FailureRate = &failureRate
+		}
+		rows = append(rows, *row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Group < rows[j].Group
+	})
+	return rows, nil
+}
+
+// usageReportData returns the header and the rows of the usage report as strings, ready to be displayed.
+func usageReportData(
+	rows []usageReportRow,
+	groupBy string,
+	sizes sizeOptions,
+) ([]string, [][]string) {
+	header := []string{
+		groupBy,
+		"runs",
+		"finished",
+		"failed",
+		"failure_rate",
+		"duration",
+		"jobs",
+		"failed_jobs",
+		"workspace_size",
+	}
+	var data [][]string
+	for _, row := range rows {
+		failureRate := "-"
+		if row.FailureRate != nil {
+			failureRate = fmt.Sprintf("%.0f%%", *row.FailureRate*100)
+		}
+		duration := strconv.FormatInt(row.Duration, 10)
+		if sizes.showHumanReadable() {
+			duration = (time.Duration(row.Duration) * time.Second).String()
+		}
+		data = append(data, []string{
+			row.Group,
+			strconv.Itoa(row.Runs),
+			strconv.Itoa(row.Finished),
+			strconv.Itoa(row.Failed),
+			failureRate,
+			duration,
+			strconv.FormatInt(row.Jobs, 10),
+			strconv.FormatInt(row.FailedJobs, 10),
+			sizes.formatSize(row.WorkspaceSize),
+		})
+	}
+	return header, data
+}
+
+// displayUsageReportCSV writes the usage report in CSV format, with raw values.
+func displayUsageReportCSV(rows []usageReportRow, groupBy string, out io.Writer) error {
+	header, data := usageReportData(rows, groupBy, sizeOptions{})
+	for i, row := range rows {
+		data[i][4] = ""
+		if row.FailureRate != nil {
+			data[i][4] = strconv.FormatFloat(*row.FailureRate, 'f', 4, 64)
+		}
+	}
+
+	writer := csv.NewWriter(out)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(data); err != nil {
+		return err
+	}
+	return writer.Error()
+}
@@ -0,0 +1,141 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+var reportServerPath = "/api/workflows"
+
+func TestReport(t *testing.T) {
+	listResponse := map[string]ServerResponse{
+		reportServerPath: {
+			statusCode:   http.StatusOK,
+			responseFile: "report_usage_list.json",
+		},
+	}
+
+	tests := map[string]TestCmdParams{
+		"missing report type": {
+			args:      []string{},
+			wantError: true,
+			expected:  []string{"accepts 1 arg(s), received 0"},
+		},
+		"invalid report type": {
+			args:      []string{"costs"},
+			wantError: true,
+			expected:  []string{"invalid argument \"costs\""},
+		},
+		"invalid group by": {
+			args:      []string{"usage", "--group-by", "user"},
+			wantError: true,
+			expected:  []string{"invalid value for 'group-by'"},
+		},
+		"invalid since": {
+			serverResponses: listResponse,
+			args:            []string{"usage", "--since", "yesterday"},
+			wantError:       true,
+			expected:        []string{"invalid value for '--since'"},
+		},
+		"json and csv": {
+			args:      []string{"usage", "--json", "--csv"},
+			wantError: true,
+			expected:  []string{"please provide either --json or --csv, not both"},
+		},
+		"group by name": {
+			serverResponses: listResponse,
+			args:            []string{"usage", "--since", "2026-01-01"},
+			expected: []string{
+				"NAME", "RUNS", "FAILURE_RATE", "DURATION", "JOBS", "WORKSPACE_SIZE",
+				"analysis", "50%", "6000", "3072",
+				"fit", "0%", "20", "1048576",
+			},
+			unwanted: []string{"old"},
+		},
+		"all runs": {
+			serverResponses: listResponse,
+			args:            []string{"usage"},
+			expected:        []string{"analysis", "fit", "old"},
+		},
+		"until": {
+			serverResponses: listResponse,
+			args:            []string{"usage", "--until", "2026-01-01"},
+			expected:        []string{"old"},
+			unwanted:        []string{"analysis", "fit"},
+		},
+		"human readable": {
+			serverResponses: listResponse,
+			args:            []string{"usage", "--since", "2026-01-01", "-h"},
+			expected:        []string{"1h40m0s", "3 KiB", "20s", "1 MiB"},
+		},
+		"group by month": {
+			serverResponses: listResponse,
+			args: []string{
+				"usage", "--since", "2026-01-01", "--group-by", "month",
+			},
+			expected: []string{"MONTH", "2026-01", "2026-02"},
+			unwanted: []string{"2025-12"},
+		},
+		"csv": {
+			serverResponses: listResponse,
+			args:            []string{"usage", "--since", "2026-01-01", "--csv"},
+			expected: []string{
+				"name,runs,finished,failed,failure_rate,duration,jobs,failed_jobs,workspace_size",
+				"analysis,3,1,1,0.5000,6000,5,1,3072",
+				"fit,1,0,0,0.0000,20,3,0,1048576",
+			},
+		},
+		"json": {
+			serverResponses: listResponse,
+			args: []string{
+				"usage", "--since", "2026-01-01", "--group-by", "status", "--json",
+			},
+			expected: []string{
+				"\"group\": \"deleted\"",
+				"\"failure_rate\": null",
+				"\"group\": \"failed\"",
+				"\"failure_rate\": 1",
+				"\"duration\": 1800",
+			},
+		},
+		"no runs": {
+			serverResponses: listResponse,
+			args:            []string{"usage", "--since", "2030-01-01"},
+			expected:        []string{"No workflow runs found in the given time range."},
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "report"
+			testCmdRun(t, params)
+		})
+	}
+}
+
+func TestParseReportTime(t *testing.T) {
+	got, err := parseReportTime("2026-01-01", "since")
+	if err != nil || !got.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected 2026-01-01, got %v, %v", got, err)
+	}
+
+	got, err = parseReportTime("", "since")
+	if err != nil || !got.IsZero() {
+		t.Errorf("Expected zero time, got %v, %v", got, err)
+	}
+
+	got, err = parseReportTime("2d", "since")
+	expected := time.Now().UTC().Add(-48 * time.Hour)
+	if err != nil || got.Sub(expected).Abs() > time.Minute {
+		t.Errorf("Expected about %v, got %v, %v", expected, got, err)
+	}
+}
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -22,9 +22,14 @@ import (
 const retentionRulesListDesc = `
 List the retention rules for a workflow.
 
-Example:
+The rules are sorted by retention days, unless other columns are given with
+` + "``--sort``" + `.
+
+Examples:
 
 	 $ reana-client retention-rules-list -w myanalysis.42
+
+	 $ reana-client retention-rules-list -w myanalysis.42 --sort status,-apply_on
 `
 
 const retentionRulesListFormatFlagDesc = `Format output according to column titles or column
@@ -37,6 +42,7 @@ type retentionRulesListOptions struct {
 	workflow      string
 	jsonOutput    bool
 	formatFilters []string
+	sortOptions
 }
 
 // newRetentionRulesListCmd creates a command to list retention rules.
@@ -76,10 +82,20 @@ func newRetentionRulesListCmd() *cobra.Command {
 		retentionRulesListFormatFlagDesc,
 	)
 
+	addSortFlag(f, &o.sortOptions, []string{"retention_days"})
+
+	registerFlagCompletion(cmd, "format", completeColumns(retentionRulesColumns))
+	registerFlagCompletion(cmd, "sort", completeSortColumns(retentionRulesColumns))
+
 	return cmd
 }
 
 func (o *retentionRulesListOptions) run(cmd *cobra.Command) error {
+	sortKeys, err := o.sortKeys()
+	if err != nil {
+		return err
+	}
+
 	retentionRulesParams := operations.NewGetWorkflowRetentionRulesParams()
 	retentionRulesParams.SetAccessToken(&o.token)
 	retentionRulesParams.SetWorkflowIDOrName(o.workflow)
@@ -95,8 +111,10 @@ func (o *retentionRulesListOptions) run(cmd *cobra.Command) error {
 		return err
 	}
 
-	df := buildRetentionRulesDataFrame(retentionRulesResp)
-	df = df.Arrange(dataframe.Sort("retention_days"))
+	df, err := formatter.SortDataFrame(buildRetentionRulesDataFrame(retentionRulesResp), sortKeys)
+	if err != nil {
+		return err
+	}
 
 	parsedFormatFilters := formatter.ParseFormatParameters(
 		o.formatFilters,
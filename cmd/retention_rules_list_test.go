@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022 CERN.
+Copyright (C) 2022, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -74,6 +74,16 @@ func TestRetentionRulesList(t *testing.T) {
 			expected: []string{"\"apply_on\": null,"},
 			unwanted: []string{"-"},
 		},
+		"sorted": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(retentionRulesPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "retention_rules_active.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "--sort", "status,-apply_on", "--format", "workspace_files"},
+			expected: []string{"**/*.txt       \n*.csv"},
+		},
 		"format filters": {
 			serverResponses: map[string]ServerResponse{
 				fmt.Sprintf(retentionRulesPathTemplate, workflowName): {
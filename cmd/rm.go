@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -22,19 +22,29 @@ const rmDesc = `
 Delete files from workspace.
 
 The ` + "``rm``" + ` command allow to delete files and directories from workspace.
-Note that you can use glob to remove similar files.
+Note that you can use glob patterns to remove similar files: '*' matches any
+characters except '/', '**' matches any characters including '/', and braces
+list alternatives, e.g. '{a,b}'. A pattern matching a directory removes all the
+files it contains. Patterns using '**' or braces are resolved by the client,
+which deletes the matching files one by one, whereas other patterns are
+resolved by the server.
 
 Examples:
 
 	$ reana-client rm -w myanalysis.42 data/mydata.csv
 
 	$ reana-client rm -w myanalysis.42 'data/*root*'
+
+	$ reana-client rm -w myanalysis.42 '**/*.{tmp,log}'
+
+	$ reana-client rm -w myanalysis.42 'data/*root*' -h
 `
 
 type rmOptions struct {
 	token     string
 	workflow  string
 	fileNames []string
+	sizeOptions
 }
 
 // newRmCmd creates a command to delete files from workspace.
@@ -48,6 +58,9 @@ func newRmCmd() *cobra.Command {
 		Args:  cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			o.fileNames = args
+			if err := o.validateSizeFlags(); err != nil {
+				return err
+			}
 			return o.run(cmd)
 		},
 	}
@@ -67,6 +80,11 @@ func newRmCmd() *cobra.Command {
 		"",
 		"Name or UUID of the workflow. Overrides value of REANA_WORKON environment variable.",
 	)
+	addSizeFlags(f, &o.sizeOptions, "Show the freed up disk space in human readable format.", false)
+	// Remove -h shorthand
+	cmd.PersistentFlags().BoolP("help", "", false, "Help for rm")
+
+	cmd.ValidArgsFunction = completeWorkspacePaths
 
 	return cmd
 }
@@ -77,20 +95,33 @@ func (o *rmOptions) run(cmd *cobra.Command) error {
 		return err
 	}
 
+	resolvedNames, err := resolveExtendedWorkspacePatterns(api, o.token, o.workflow, o.fileNames)
+	if err != nil {
+		return err
+	}
+
 	hasError := false
-	for _, fileName := range o.fileNames {
-		rmParams := operations.NewDeleteFileParams()
-		rmParams.SetAccessToken(&o.token)
-		rmParams.SetWorkflowIDOrName(o.workflow)
-		rmParams.SetFileName(fileName)
-
-		rmResp, err := api.Operations.DeleteFile(rmParams)
-		if err != nil {
-			return err
+	for i, fileName := range o.fileNames {
+		deleted := map[string]operations.DeleteFileOKBodyDeletedAnon{}
+		failed := map[string]operations.DeleteFileOKBodyFailedAnon{}
+		for _, name := range resolvedNames[i] {
+			rmParams := operations.NewDeleteFileParams()
+			rmParams.SetAccessToken(&o.token)
+			rmParams.SetWorkflowIDOrName(o.workflow)
+			rmParams.SetFileName(name)
+
+			rmResp, err := api.Operations.DeleteFile(rmParams)
+			if err != nil {
+				return err
+			}
+			for file, fileInfo := range rmResp.Payload.Deleted {
+				deleted[file] = fileInfo
+			}
+			for file, errorInfo := range rmResp.Payload.Failed {
+				failed[file] = errorInfo
+			}
 		}
 
-		deleted := rmResp.Payload.Deleted
-		failed := rmResp.Payload.Failed
 		if len(deleted) == 0 && len(failed) == 0 {
 			hasError = true
 			displayer.DisplayMessage(
@@ -125,8 +156,12 @@ func (o *rmOptions) run(cmd *cobra.Command) error {
 			)
 		}
 		if freedSpace > 0 {
+			freedMsg := fmt.Sprintf("%d bytes freed up.", freedSpace)
+			if o.showHumanReadable() {
+				freedMsg = fmt.Sprintf("%s freed up.", o.formatSize(freedSpace))
+			}
 			displayer.DisplayMessage(
-				fmt.Sprintf("%d bytes freed up.", freedSpace),
+				freedMsg,
 				displayer.Success,
 				false,
 				cmd.OutOrStdout(),
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022 CERN.
+Copyright (C) 2022, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -21,12 +21,12 @@ func TestRm(t *testing.T) {
 	tests := map[string]TestCmdParams{
 		"multiple files": {
 			serverResponses: map[string]ServerResponse{
-				fmt.Sprintf(rmPathTemplate, workflowName, "files/*"): {
+				fmt.Sprintf(rmPathTemplate, workflowName, "files"): {
 					statusCode:   http.StatusOK,
 					responseFile: "rm_multiple_files.json",
 				},
 			},
-			args: []string{"-w", workflowName, "files/*"},
+			args: []string{"-w", workflowName, "files"},
 			expected: []string{
 				"File files/one.py was successfully deleted",
 				"File files/two.py was successfully deleted",
@@ -36,14 +36,26 @@ func TestRm(t *testing.T) {
 			},
 			wantError: true,
 		},
+		"human readable freed space": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(rmPathTemplate, workflowName, "files"): {
+					statusCode:   http.StatusOK,
+					responseFile: "rm_multiple_files.json",
+				},
+			},
+			args:      []string{"-w", workflowName, "files", "-h"},
+			expected:  []string{"60 Bytes freed up"},
+			unwanted:  []string{"60 bytes freed up"},
+			wantError: true,
+		},
 		"no space freed": {
 			serverResponses: map[string]ServerResponse{
-				fmt.Sprintf(rmPathTemplate, workflowName, "files/*"): {
+				fmt.Sprintf(rmPathTemplate, workflowName, "files"): {
 					statusCode:   http.StatusOK,
 					responseFile: "rm_no_freed.json",
 				},
 			},
-			args: []string{"-w", workflowName, "files/*"},
+			args: []string{"-w", workflowName, "files"},
 			expected: []string{
 				"File files/empty.py was successfully deleted",
 			},
@@ -54,14 +66,71 @@ func TestRm(t *testing.T) {
 		},
 		"no matching files": {
 			serverResponses: map[string]ServerResponse{
-				fmt.Sprintf(rmPathTemplate, workflowName, "files/*"): {
+				fmt.Sprintf(rmPathTemplate, workflowName, "files"): {
 					statusCode:   http.StatusOK,
 					responseFile: "rm_empty.json",
 				},
 			},
-			args: []string{"-w", workflowName, "files/*"},
+			args: []string{"-w", workflowName, "files"},
+			expected: []string{
+				"files did not match any existing file",
+			},
+			wantError: true,
+		},
+		"glob pattern": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+				fmt.Sprintf(rmPathTemplate, workflowName, "code/gendata.C"): {
+					statusCode:   http.StatusOK,
+					responseFile: "rm_gendata.json",
+				},
+				fmt.Sprintf(rmPathTemplate, workflowName, "results/data.root"): {
+					statusCode:   http.StatusOK,
+					responseFile: "rm_data_root.json",
+				},
+			},
+			args: []string{"-w", workflowName, "**/*.{C,root}"},
+			expected: []string{
+				"File code/gendata.C was successfully deleted",
+				"File results/data.root was successfully deleted",
+				"156392 bytes freed up",
+			},
+		},
+		"server-side glob pattern": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(rmPathTemplate, workflowName, "files/*.py"): {
+					statusCode:   http.StatusOK,
+					responseFile: "rm_multiple_files.json",
+				},
+			},
+			args: []string{"-w", workflowName, "files/*.py"},
+			expected: []string{
+				"File files/one.py was successfully deleted",
+				"File files/two.py was successfully deleted",
+				"60 bytes freed up",
+			},
+			wantError: true,
+		},
+		"glob pattern without matching files": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+			},
+			args: []string{"-w", workflowName, "**/*.txt"},
+			expected: []string{
+				"**/*.txt did not match any existing file",
+			},
+			wantError: true,
+		},
+		"invalid glob pattern": {
+			args: []string{"-w", workflowName, "data/{a,b"},
 			expected: []string{
-				"files/* did not match any existing file",
+				"invalid pattern 'data/{a,b': unterminated braces",
 			},
 			wantError: true,
 		},
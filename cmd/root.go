@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2023, 2025 CERN.
+Copyright (C) 2022, 2023, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -59,6 +59,7 @@ func NewRootCmd() *cobra.Command {
 			Message: "Quota commands:",
 			Commands: []*cobra.Command{
 				newQuotaShowCmd(),
+				newQuotaHistoryCmd(),
 			},
 		},
 		{
@@ -75,20 +76,24 @@ func NewRootCmd() *cobra.Command {
 			Commands: []*cobra.Command{
 				// create
 				newDiffCmd(),
+				newCompareCmd(),
 				newDeleteCmd(),
 				newListCmd(),
+				newReportCmd(),
 			},
 		},
 		{
 			Message: "Workflow execution commands:",
 			Commands: []*cobra.Command{
 				// run
-				// validate
+				newValidateCmd(),
 				newStopCmd(),
 				newRestartCmd(),
 				newLogsCmd(),
+				newLogsSearchCmd(),
 				newStartCmd(),
 				newStatusCmd(),
+				newTimelineCmd(),
 			},
 		},
 		{
@@ -97,6 +102,13 @@ func NewRootCmd() *cobra.Command {
 				newShareAddCmd(),
 				newShareRemoveCmd(),
 				newShareStatusCmd(),
+				newShareListCmd(),
+				newShareAuditCmd(),
+				newSharedWithMeCmd(),
+				newCollaboratorsCmd(),
+				newShareGroupAddCmd(),
+				newShareGroupRemoveCmd(),
+				newShareGroupListCmd(),
 			},
 		},
 		{
@@ -104,6 +116,8 @@ func NewRootCmd() *cobra.Command {
 			Commands: []*cobra.Command{
 				newOpenCmd(),
 				newCloseCmd(),
+				newSessionsCmd(),
+				newSessionTypesCmd(),
 			},
 		},
 		{
@@ -116,12 +130,14 @@ func NewRootCmd() *cobra.Command {
 				newRmCmd(),
 				newPruneCmd(),
 				newMvCmd(),
+				newCpCmd(),
 			},
 		},
 		{
 			Message: "Workspace file retention commands:",
 			Commands: []*cobra.Command{
 				newRetentionRulesListCmd(),
+				newCleanupCmd(),
 			},
 		},
 		{
@@ -134,6 +150,7 @@ func NewRootCmd() *cobra.Command {
 		},
 	}
 	commandGroups.Add(cmd)
+	registerWorkflowCompletion(cmd)
 	commandGroups.SetUsageTemplate(cmd)
 	return cmd
 }
@@ -186,6 +203,10 @@ func validateFlags(cmd *cobra.Command) error {
 		if err := bindViperToCmdFlag(workflow); err != nil {
 			return err
 		}
+		selectable := ok && slices.Contains(properties, "selectable")
+		if selectable && isBulkSelection(cmd.Flags()) {
+			return nil
+		}
 		workflowValue := workflow.Value.String()
 		if err := validator.ValidateWorkflow(workflowValue); err != nil {
 			return err
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2024, 2025 CERN.
+Copyright (C) 2022, 2024, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -77,7 +77,23 @@ func getResponseFile(callSeqNum int, serverResponse ServerResponse) string {
 	return serverResponse.responseFile
 }
 
+// defaultServerResponses are used to answer the requests made to check the server version
+// and configuration, when the test does not provide a response for them.
+var defaultServerResponses = map[string]ServerResponse{
+	"/api/you": {
+		statusCode:   http.StatusOK,
+		responseFile: "ping_latest.json",
+	},
+	"/api/config": {
+		statusCode:   http.StatusOK,
+		responseFile: "config_default.json",
+	},
+}
+
 func testCmdRun(t *testing.T, p TestCmdParams) {
+	// Isolate the cached server information of each test.
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
 	callSeqNum := 0
 	server := httptest.NewTLSServer(
 		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -85,10 +101,16 @@ func testCmdRun(t *testing.T, p TestCmdParams) {
 				t.Errorf("Expected access token '1234', got '%v'", accessToken)
 			}
 			res, validPath := p.serverResponses[r.URL.Path]
+			if !validPath {
+				// Does not count as a call, so that it does not affect additional response files.
+				if res, validPath = defaultServerResponses[r.URL.Path]; validPath {
+					callSeqNum--
+				}
+			}
 			if validPath {
 				w.Header().Add("Content-Type", "application/json")
 				for name, value := range res.responseHeaders {
-					w.Header().Add(name, value)
+					w.Header().Set(name, value)
 				}
 				w.WriteHeader(res.statusCode)
 
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -55,6 +55,8 @@ func newSecretsDeleteCmd() *cobra.Command {
 		"Access token of the current user.",
 	)
 
+	cmd.ValidArgsFunction = completeSecrets
+
 	return cmd
 }
 
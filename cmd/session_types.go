@@ -0,0 +1,105 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/sessiontypes"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const sessionTypesDesc = `
+List the types of interactive sessions supported by the server.
+
+The ` + "``session-types``" + ` command lists the types of interactive sessions that can
+be opened with the ` + "``open``" + ` command, such as Jupyter notebooks, VS Code or
+RStudio servers, together with their default and recommended images and the
+resources allocated to them. Jupyter notebooks are supported by every server,
+the other types are available when the server advertises them.
+
+The default images and the resources are set by the server. The default image
+can be replaced with the ` + "``--image``" + ` option of ` + "``open``" + `, whereas the
+resources are always the ones of the server and cannot be changed by ` + "``open``" + `.
+
+Examples:
+
+  $ reana-client session-types
+
+  $ reana-client open -w myanalysis.42 rstudio
+`
+
+type sessionTypesOptions struct {
+	token      string
+	jsonOutput bool
+}
+
+// newSessionTypesCmd creates a command to list the types of interactive sessions supported by the server.
+func newSessionTypesCmd() *cobra.Command {
+	o := &sessionTypesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "session-types",
+		Short: "List the types of interactive sessions supported by the server.",
+		Long:  sessionTypesDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(
+		&o.token,
+		"access-token",
+		"t",
+		"",
+		"Access token of the current user.",
+	)
+	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
+
+	return cmd
+}
+
+func (o *sessionTypesOptions) run(cmd *cobra.Command) error {
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	info, err := getServerInfo(api, o.token, false)
+	if err != nil {
+		log.Debugf("Could not retrieve the server info: %v", err)
+	}
+	types := getSessionTypes(api, o.token, info)
+
+	out := cmd.OutOrStdout()
+	if o.jsonOutput {
+		return displayer.DisplayJsonOutput(types, out)
+	}
+
+	var rows [][]string
+	for _, sessionType := range types {
+		rows = append(rows, []string{
+			sessionType.Name,
+			sessionType.Title,
+			sessionType.DefaultImage,
+			strings.Join(sessionType.RecommendedImages, "\n"),
+			sessiontypes.FormatResources(sessionType.Resources),
+		})
+	}
+	displayer.DisplayTable(
+		[]string{"type", "title", "server_default_image", "recommended_images", "server_resources"},
+		rows,
+		out,
+	)
+	return nil
+}
@@ -0,0 +1,87 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestSessionTypes(t *testing.T) {
+	tests := map[string]TestCmdParams{
+		"default types": {
+			serverResponses: map[string]ServerResponse{
+				infoURL: {
+					statusCode:   http.StatusOK,
+					responseFile: "info_big.json",
+				},
+			},
+			expected: []string{
+				"TYPE", "TITLE", "SERVER_DEFAULT_IMAGE", "RECOMMENDED_IMAGES", "SERVER_RESOURCES",
+				"jupyter", "Jupyter notebook", "docker.io/jupyter/scipy-notebook:notebook-6.4.5",
+			},
+			unwanted: []string{"rstudio", "vscode"},
+		},
+		"types advertised by the server": {
+			serverResponses: map[string]ServerResponse{
+				infoURL: {
+					statusCode:   http.StatusOK,
+					responseFile: "info_small.json",
+				},
+				configServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "config_session_types.json",
+				},
+			},
+			expected: []string{
+				"jupyter",
+				"rstudio", "RStudio server", "docker.io/rocker/tidyverse:4.4", "cpu=2, memory=4Gi",
+				"vscode", "VS Code server",
+			},
+		},
+		"json": {
+			serverResponses: map[string]ServerResponse{
+				infoURL: {
+					statusCode:   http.StatusOK,
+					responseFile: "info_small.json",
+				},
+				configServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "config_session_types.json",
+				},
+			},
+			args: []string{"--json"},
+			expected: []string{
+				"\"name\": \"rstudio\"",
+				"\"default_image\": \"docker.io/rocker/rstudio:4.4\"",
+				"\"connection_hint\": \"Open the URL in your web browser to use RStudio.\"",
+			},
+		},
+		"server without configuration": {
+			serverResponses: map[string]ServerResponse{
+				infoURL: {
+					statusCode:   http.StatusOK,
+					responseFile: "info_small.json",
+				},
+				configServerPath: {
+					statusCode:   http.StatusInternalServerError,
+					responseFile: "common_internal_server_error.json",
+				},
+			},
+			expected: []string{"jupyter", "Jupyter notebook"},
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "session-types"
+			testCmdRun(t, params)
+		})
+	}
+}
@@ -0,0 +1,269 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/datautils"
+	"reanahub/reana-client-go/pkg/displayer"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
+)
+
+const sessionsDesc = `
+List the open interactive sessions and close the ones of inactive workflows.
+
+The ` + "``sessions``" + ` command lists the interactive sessions that are open on any
+of your workflows, together with their status and the time since which the
+workflow is inactive, i.e. not queued, pending or running. The session URLs
+are displayed without your access token.
+
+With ` + "``--close-idle``" + `, the sessions whose workflow finished, stopped or was
+created more than ` + "``--idle-after``" + ` ago, and is not active since then, are
+closed. The server does not report when the sessions themselves were last used,
+so a session opened recently on an old workflow is closed as well.
+
+Examples:
+
+  $ reana-client sessions
+
+  $ reana-client sessions --close-idle --idle-after 3d
+`
+
+type sessionsOptions struct {
+	token     string
+	serverURL string
+	closeIdle bool
+	idleAfter string
+	yes       bool
+}
+
+// session represents an interactive session open on a workflow.
+type session struct {
+	workflow       string
+	sessionType    string
+	sessionStatus  string
+	workflowStatus string
+	lastActivity   time.Time
+	uri            string
+	// idle is true if the workflow is inactive since before the --idle-after threshold,
+	// which says nothing about the use of the session itself
+	idle bool
+	// workflowActive is true if the workflow is queued, pending or running
+	workflowActive bool
+}
+
+// newSessionsCmd creates a command to list the interactive sessions and close the ones of inactive workflows.
+func newSessionsCmd() *cobra.Command {
+	o := &sessionsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sessions",
+		Short: "List the open interactive sessions and close the ones of inactive workflows.",
+		Long:  sessionsDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.serverURL = viper.GetString("server-url")
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(
+		&o.token,
+		"access-token",
+		"t",
+		"",
+		"Access token of the current user.",
+	)
+	f.BoolVar(&o.closeIdle, "close-idle", false, "Close the interactive sessions of inactive workflows.")
+	f.StringVar(
+		&o.idleAfter,
+		"idle-after",
+		"1d",
+		"Time since the last workflow activity after which its session is closed, e.g. 12h or 3d.",
+	)
+	f.BoolVarP(
+		&o.yes,
+		"yes",
+		"y",
+		false,
+		"Do not ask for confirmation when closing the sessions.",
+	)
+
+	return cmd
+}
+
+func (o *sessionsOptions) run(cmd *cobra.Command) error {
+	idleAfter, err := datautils.ParseDuration(o.idleAfter)
+	if err != nil {
+		return fmt.Errorf("invalid value for '--idle-after': %s", err.Error())
+	}
+
+	includeProgress := true
+	listParams := operations.NewGetWorkflowsParams()
+	listParams.SetAccessToken(&o.token)
+	listParams.SetType("interactive")
+	listParams.SetIncludeProgress(&includeProgress)
+
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	listResp, err := api.Operations.GetWorkflows(listParams)
+	if err != nil {
+		return err
+	}
+
+	sessions, err := buildSessions(
+		listResp.Payload.Items,
+		o.serverURL,
+		time.Now().UTC().Add(-idleAfter),
+	)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if len(sessions) == 0 {
+		displayer.DisplayMessage(
+			"There are no open interactive sessions.",
+			displayer.Info,
+			false,
+			out,
+		)
+		return nil
+	}
+
+	var rows [][]string
+	var idle []string
+	for _, s := range sessions {
+		if s.idle {
+			idle = append(idle, s.workflow)
+		}
+		inactiveSince := "-"
+		if !s.workflowActive {
+			inactiveSince = s.lastActivity.Format("2006-01-02T15:04:05")
+		}
+		rows = append(rows, []string{
+			s.workflow,
+			s.sessionType,
+			s.sessionStatus,
+			s.workflowStatus,
+			inactiveSince,
+			s.uri,
+		})
+	}
+	displayer.DisplayTable(
+		[]string{
+			"workflow",
+			"session_type",
+			"session_status",
+			"workflow_status",
+			"workflow_inactive_since",
+			"session_uri",
+		},
+		rows,
+		out,
+	)
+
+	if !o.closeIdle {
+		return nil
+	}
+	if len(idle) == 0 {
+		displayer.DisplayMessage(
+			"There are no interactive sessions of inactive workflows to close.",
+			displayer.Info,
+			false,
+			out,
+		)
+		return nil
+	}
+	displayer.DisplayMessage(
+		fmt.Sprintf(
+			"Sessions are selected by the inactivity of their workflow for more than %s, "+
+				"not by their own use: they may still be in use.",
+			o.idleAfter,
+		),
+		displayer.Warning,
+		false,
+		out,
+	)
+	return confirmAndRunBulkAction(
+		cmd,
+		idle,
+		"close the interactive session of",
+		o.yes,
+		func(workflow string) (string, error) {
+			closeParams := operations.NewCloseInteractiveSessionParams()
+			closeParams.SetAccessToken(&o.token)
+			closeParams.SetWorkflowIDOrName(workflow)
+			if _, err := api.Operations.CloseInteractiveSession(closeParams); err != nil {
+				return "", err
+			}
+			return "session closed", nil
+		},
+	)
+}
+
+// buildSessions returns the interactive sessions open on the given workflows.
+// Sessions whose workflow is inactive since before idleSince are marked as idle,
+// regardless of when the sessions themselves were last used.
+func buildSessions(
+	items []*operations.GetWorkflowsOKBodyItemsItems0,
+	serverURL string,
+	idleSince time.Time,
+) ([]session, error) {
+	var sessions []session
+	for _, workflow := range items {
+		if workflow.SessionURI == "" && workflow.SessionStatus == "" {
+			continue
+		}
+
+		lastActivity := workflow.Created
+		if progress := workflow.Progress; progress != nil {
+			for _, date := range []*string{
+				progress.RunStartedAt,
+				progress.RunFinishedAt,
+				progress.RunStoppedAt,
+			} {
+				if date != nil && *date > lastActivity {
+					lastActivity = *date
+				}
+			}
+		}
+		lastActivityTime, err := datautils.FromIsoToTimestamp(lastActivity)
+		if err != nil {
+			return nil, err
+		}
+
+		uri := ""
+		if workflow.SessionURI != "" {
+			uri = serverURL + workflow.SessionURI
+		}
+		active := slices.Contains(config.InteractiveSessionActiveStatuses, workflow.Status)
+		sessions = append(sessions, session{
+			workflow:       workflow.Name,
+			sessionType:    workflow.SessionType,
+			sessionStatus:  workflow.SessionStatus,
+			workflowStatus: workflow.Status,
+			lastActivity:   lastActivityTime,
+			uri:            uri,
+			idle:           !active && lastActivityTime.Before(idleSince),
+			workflowActive: active,
+		})
+	}
+	return sessions, nil
+}
@@ -0,0 +1,102 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSessions(t *testing.T) {
+	tests := map[string]TestCmdParams{
+		"list sessions": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			expected: []string{
+				"WORKFLOW", "SESSION_TYPE", "SESSION_STATUS", "WORKFLOW_INACTIVE_SINCE",
+				"my_workflow.23", "2022-07-28T12:13:10",
+				"my_workflow2.12", "running           -",
+				"/session1uri",
+			},
+			unwanted: []string{"token=1234", "Do you want to", "IDLE", "2022-08-10T18:04:52"},
+		},
+		"no sessions": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "common_empty.json",
+				},
+			},
+			expected: []string{"There are no open interactive sessions."},
+		},
+		"close idle sessions": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+				fmt.Sprintf(closePathTemplate, "my_workflow.23"): {
+					statusCode:   http.StatusOK,
+					responseFile: "common_empty.json",
+				},
+			},
+			args: []string{"--close-idle", "--yes"},
+			expected: []string{
+				"Sessions are selected by the inactivity of their workflow for more than 1d",
+				"my_workflow.23", "session closed",
+			},
+		},
+		"close idle sessions with none idle": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:     []string{"--close-idle", "--idle-after", "100000d"},
+			expected: []string{"There are no interactive sessions of inactive workflows to close."},
+			unwanted: []string{"session closed"},
+		},
+		"close idle session fails": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+				fmt.Sprintf(closePathTemplate, "my_workflow.23"): {
+					statusCode:   http.StatusNotFound,
+					responseFile: "close_no_open.json",
+				},
+			},
+			args: []string{"--close-idle", "-y"},
+			expected: []string{
+				"has no open interactive session",
+				"Failed to close the interactive session of 1 out of 1 workflow(s).",
+			},
+			wantError: true,
+		},
+		"invalid idle duration": {
+			args:      []string{"--idle-after", "later"},
+			expected:  []string{"invalid value for '--idle-after'"},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "sessions"
+			testCmdRun(t, params)
+		})
+	}
+}
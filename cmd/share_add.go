@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2023, 2024, 2025 CERN.
+Copyright (C) 2023, 2024, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -9,6 +9,7 @@ under the terms of the MIT License; see LICENSE file for more details.
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"reanahub/reana-client-go/client"
 	"reanahub/reana-client-go/client/operations"
@@ -16,6 +17,7 @@ import (
 	"reanahub/reana-client-go/pkg/displayer"
 	"reanahub/reana-client-go/pkg/errorhandler"
 	"reanahub/reana-client-go/pkg/validator"
+	"reanahub/reana-client-go/pkg/workflows"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -34,6 +36,20 @@ Examples:
   $ reana-client share-add -w myanalysis.42 --user bob@cern.ch
   --user cecile@cern.ch --message "Please review my analysis"
   --valid-until 2024-12-31
+
+You can also share all the workflows matching a selection by using the
+` + "`--filter`" + `, ` + "`--older-than`" + ` and ` + "`--name-prefix`" + ` flags instead of ` + "`-w`" + `,
+or all the runs of a workflow by using ` + "`--all-runs`" + `.
+
+  $ reana-client share-add --name-prefix higgs- --filter status=finished
+  --user bob@cern.ch --yes
+
+  $ reana-client share-add -w myanalysis --all-runs --user bob@cern.ch
+
+Local sharing groups created with ` + "`share-group-add`" + ` can be given instead of
+user emails by prefixing their name with @.
+
+  $ reana-client share-add -w myanalysis.42 --user @higgs-team
 `
 
 type shareAddOptions struct {
@@ -42,6 +58,8 @@ type shareAddOptions struct {
 	users      []string
 	message    string
 	validUntil string
+	allRuns    bool
+	selector   bulkSelector
 }
 
 // newShareAddCmd creates a command to share a workflow with other users.
@@ -59,6 +77,39 @@ func newShareAddCmd() *cobra.Command {
 			); err != nil {
 				return fmt.Errorf("%s\n%s", err.Error(), cmd.UsageString())
 			}
+			if err := o.selector.validate(cmd.Flags()); err != nil {
+				return err
+			}
+			if o.allRuns && isBulkSelection(cmd.Flags()) {
+				return fmt.Errorf(
+					"please provide either --all-runs or one of --%s, not both",
+					strings.Join(config.BulkSelectorFlags, ", --"),
+				)
+			}
+			if o.validUntil != "" {
+				if err := validator.ValidateFutureDate(o.validUntil, "valid-until"); err != nil {
+					return err
+				}
+			}
+			users, err := expandUserGroups(o.users)
+			if err != nil {
+				return err
+			}
+			o.users = users
+
+			api, err := client.ApiClient()
+			if err != nil {
+				return err
+			}
+			if err := requireServerFeature(api, o.token, "sharing", "share-add"); err != nil {
+				return err
+			}
+			if o.allRuns {
+				return o.runAllRuns(cmd)
+			}
+			if isBulkSelection(cmd.Flags()) {
+				return o.runBulk(cmd)
+			}
 			return o.run(cmd)
 		},
 	}
@@ -83,7 +134,8 @@ func newShareAddCmd() *cobra.Command {
 		"user",
 		"u",
 		[]string{},
-		`Users to share the workflow with.`,
+		`Users to share the workflow with. Local
+	sharing groups can be given as @name.`,
 	)
 	f.StringVarP(
 		&o.message,
@@ -101,6 +153,14 @@ func newShareAddCmd() *cobra.Command {
 	workflow will expire for the given
 	user(s) (format: YYYY-MM-DD).`,
 	)
+	f.BoolVar(
+		&o.allRuns,
+		"all-runs",
+		false,
+		`Share all the runs of the workflow given
+	with -w, instead of a single run.`,
+	)
+	o.selector.addFlags(f)
 	// Remove -h shorthand
 	cmd.PersistentFlags().BoolP("help", "h", false, "Help for share-add")
 
@@ -108,43 +168,12 @@ func newShareAddCmd() *cobra.Command {
 }
 
 func (o *shareAddOptions) run(cmd *cobra.Command) error {
-	shareAddParams := operations.NewShareWorkflowParams()
-	shareAddParams.SetAccessToken(&o.token)
-	shareAddParams.SetWorkflowIDOrName(o.workflow)
-	shareAddParams.SetShareDetails(operations.ShareWorkflowBody{
-		Message:    o.message,
-		ValidUntil: o.validUntil,
-	})
-
 	api, err := client.ApiClient()
 	if err != nil {
 		return err
 	}
 
-	shareErrors := []string{}
-	sharedUsers := []string{}
-
-	for _, user := range o.users {
-		log.Infof("Sharing workflow %s with user %s", o.workflow, user)
-
-		shareAddParams.ShareDetails.UserEmailToShareWith = &user
-		_, err := api.Operations.ShareWorkflow(shareAddParams)
-
-		if err != nil {
-			err := errorhandler.HandleApiError(err)
-			shareErrors = append(
-				shareErrors,
-				fmt.Sprintf(
-					"Failed to share %s with %s: %s",
-					o.workflow,
-					user,
-					err.Error(),
-				),
-			)
-		} else {
-			sharedUsers = append(sharedUsers, user)
-		}
-	}
+	sharedUsers, shareErrors := o.shareWorkflow(api, o.workflow)
 
 	if len(sharedUsers) > 0 {
 		displayer.DisplayMessage(
@@ -173,3 +202,92 @@ func (o *shareAddOptions) run(cmd *cobra.Command) error {
 
 	return nil
 }
+
+// runBulk shares all the workflows matching the selection flags with the given users.
+func (o *shareAddOptions) runBulk(cmd *cobra.Command) error {
+	targets, err := o.selector.resolve(o.token, nil)
+	if err != nil {
+		return err
+	}
+
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	return o.selector.runBulkAction(cmd, targets, "share", o.shareAction(api))
+}
+
+// runAllRuns shares all the runs of the workflow with the given users.
+func (o *shareAddOptions) runAllRuns(cmd *cobra.Command) error {
+	name, _ := workflows.GetNameAndRunNumber(o.workflow)
+	o.selector.filters = []string{"name=" + name}
+	targets, err := o.selector.resolve(o.token, nil)
+	if err != nil {
+		return err
+	}
+
+	// The name filter of the server also matches workflows whose name contains the given one.
+	var runs []bulkTarget
+	for _, target := range targets {
+		if runName, _ := workflows.GetNameAndRunNumber(target.name); runName == name {
+			runs = append(runs, target)
+		}
+	}
+
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	return o.selector.runBulkAction(cmd, runs, "share", o.shareAction(api))
+}
+
+// shareAction returns the bulk action sharing a workflow with the given users.
+func (o *shareAddOptions) shareAction(api *client.API) func(workflow string) (string, error) {
+	return func(workflow string) (string, error) {
+		sharedUsers, shareErrors := o.shareWorkflow(api, workflow)
+		if len(shareErrors) > 0 {
+			return "", errors.New(strings.Join(shareErrors, "; "))
+		}
+		return "shared with " + strings.Join(sharedUsers, ", "), nil
+	}
+}
+
+// shareWorkflow shares the given workflow with each of the users.
+// Returns the users the workflow was shared with and the errors that occurred.
+func (o *shareAddOptions) shareWorkflow(
+	api *client.API,
+	workflow string,
+) ([]string, []string) {
+	shareErrors := []string{}
+	sharedUsers := []string{}
+
+	for _, user := range o.users {
+		log.Infof("Sharing workflow %s with user %s", workflow, user)
+
+		shareAddParams := operations.NewShareWorkflowParams()
+		shareAddParams.SetAccessToken(&o.token)
+		shareAddParams.SetWorkflowIDOrName(workflow)
+		shareAddParams.SetShareDetails(operations.ShareWorkflowBody{
+			Message:              o.message,
+			ValidUntil:           o.validUntil,
+			UserEmailToShareWith: &user,
+		})
+		_, err := api.Operations.ShareWorkflow(shareAddParams)
+
+		if err != nil {
+			err := errorhandler.HandleApiError(err)
+			shareErrors = append(
+				shareErrors,
+				fmt.Sprintf(
+					"Failed to share %s with %s: %s",
+					workflow,
+					user,
+					err.Error(),
+				),
+			)
+		} else {
+			sharedUsers = append(sharedUsers, user)
+		}
+	}
+	return sharedUsers, shareErrors
+}
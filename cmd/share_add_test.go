@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2023 CERN.
+Copyright (C) 2023, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it under the terms
 of the MIT License; see LICENSE file for more details.
@@ -11,6 +11,7 @@ package cmd
 import (
 	"fmt"
 	"net/http"
+	"reanahub/reana-client-go/pkg/usergroups"
 	"testing"
 )
 
@@ -40,7 +41,7 @@ func TestShareAdd(t *testing.T) {
 				"-w", workflowName,
 				"--user", "bob@cern.ch",
 				"--message", "Please review my analysis",
-				"--valid-until", "2024-12-31",
+				"--valid-until", "2099-12-31",
 			},
 			expected: []string{
 				"my_workflow is now read-only shared with bob@cern.ch",
@@ -62,6 +63,146 @@ func TestShareAdd(t *testing.T) {
 			},
 			wantError: true,
 		},
+		"bulk share": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+				fmt.Sprintf(shareAddPathTemplate, "my_workflow.23"): {
+					statusCode: http.StatusOK,
+				},
+				fmt.Sprintf(shareAddPathTemplate, "my_workflow2.12"): {
+					statusCode: http.StatusOK,
+				},
+			},
+			args: []string{
+				"--older-than", "1w",
+				"--user", "bob@cern.ch",
+				"--user", "cecile@cern.ch",
+				"--yes",
+			},
+			expected: []string{
+				"my_workflow.23", "my_workflow2.12",
+				"shared with bob@cern.ch, cecile@cern.ch",
+			},
+		},
+		"bulk share failure": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+				fmt.Sprintf(shareAddPathTemplate, "my_workflow2.12"): {
+					statusCode:   http.StatusNotFound,
+					responseFile: "common_invalid_workflow.json",
+				},
+			},
+			args: []string{
+				"--name-prefix", "my_workflow2",
+				"--user", "bob@cern.ch",
+				"--yes",
+			},
+			expected: []string{
+				"failed: Failed to share my_workflow2.12 with bob@cern.ch",
+			},
+			wantError: true,
+		},
+		"valid-until in the past": {
+			args: []string{
+				"-w", workflowName,
+				"--user", "bob@cern.ch",
+				"--valid-until", "2024-12-31",
+			},
+			expected:  []string{"invalid value for 'valid-until': '2024-12-31' is in the past"},
+			wantError: true,
+		},
+		"invalid valid-until": {
+			args: []string{
+				"-w", workflowName,
+				"--user", "bob@cern.ch",
+				"--valid-until", "next week",
+			},
+			expected: []string{
+				"invalid value for 'valid-until': 'next week' is not a valid date in the format YYYY-MM-DD",
+			},
+			wantError: true,
+		},
+		"all runs": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+				fmt.Sprintf(shareAddPathTemplate, "my_workflow.23"): {
+					statusCode: http.StatusOK,
+				},
+			},
+			args: []string{
+				"-w", "my_workflow.1",
+				"--all-runs",
+				"--user", "bob@cern.ch",
+				"--yes",
+			},
+			expected: []string{"my_workflow.23", "shared with bob@cern.ch"},
+			unwanted: []string{"my_workflow2.12"},
+		},
+		"all runs with selection": {
+			args: []string{
+				"--name-prefix", "my_workflow",
+				"--all-runs",
+				"--user", "bob@cern.ch",
+			},
+			expected: []string{
+				"please provide either --all-runs or one of --filter, --older-than, --name-prefix, not both",
+			},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "share-add"
+			testCmdRun(t, params)
+		})
+	}
+}
+
+func TestShareAddWithGroups(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configDir)
+	groups := usergroups.Groups{"higgs-team": {"bob@cern.ch", "cecile@cern.ch"}}
+	path, err := usergroups.DefaultPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := usergroups.Save(path, groups); err != nil {
+		t.Fatal(err)
+	}
+
+	workflowName := "my_workflow"
+	tests := map[string]TestCmdParams{
+		"group": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(shareAddPathTemplate, workflowName): {
+					statusCode: http.StatusOK,
+				},
+			},
+			args: []string{
+				"-w", workflowName,
+				"--user", "@higgs-team",
+				"--user", "bob@cern.ch",
+				"--user", "dan@cern.ch",
+			},
+			expected: []string{
+				"my_workflow is now read-only shared with bob@cern.ch, cecile@cern.ch, dan@cern.ch",
+			},
+		},
+		"unknown group": {
+			args:      []string{"-w", workflowName, "--user", "@unknown"},
+			expected:  []string{"group @unknown does not exist"},
+			wantError: true,
+		},
 	}
 
 	for name, params := range tests {
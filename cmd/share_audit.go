@@ -0,0 +1,217 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/displayer"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const shareAuditDesc = `Report the workflow shares that expire soon.
+
+The ` + "`share-audit`" + ` command checks all your shared workflows and reports the
+shares that expire within the given number of days, as well as the ones that
+have already expired. Shares without an expiry date are not reported.
+
+Examples:
+
+  $ reana-client share-audit
+
+  $ reana-client share-audit --days 7 --json
+`
+
+type shareAuditOptions struct {
+	token      string
+	days       int
+	jsonOutput bool
+}
+
+// expiringShare a workflow share that expires soon.
+type expiringShare struct {
+	Workflow   string `json:"workflow"`
+	UserEmail  string `json:"user_email"`
+	ValidUntil string `json:"valid_until"`
+	DaysLeft   int    `json:"days_left"`
+}
+
+// newShareAuditCmd creates a command to report the workflow shares that expire soon.
+func newShareAuditCmd() *cobra.Command {
+	o := &shareAuditOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "share-audit",
+		Short: "Report the workflow shares that expire soon.",
+		Long:  shareAuditDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if o.days < 0 {
+				return errors.New("invalid value for '--days': must be a positive number")
+			}
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(
+		&o.token,
+		"access-token",
+		"t",
+		"",
+		"Access token of the current user.",
+	)
+	f.IntVar(
+		&o.days,
+		"days",
+		30,
+		"Report the shares expiring within the given number of days.",
+	)
+	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
+
+	return cmd
+}
+
+func (o *shareAuditOptions) run(cmd *cobra.Command) error {
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	if err := requireServerFeature(api, o.token, "sharing", "share-audit"); err != nil {
+		return err
+	}
+
+	sharedWith := "anybody"
+	listParams := operations.NewGetWorkflowsParams()
+	listParams.SetAccessToken(&o.token)
+	listParams.SetType("batch")
+	listParams.SetSharedWith(&sharedWith)
+	listParams.SetStatus(config.GetRunStatuses(false))
+	listResp, err := api.Operations.GetWorkflows(listParams)
+	if err != nil {
+		return err
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	shares := []expiringShare{}
+	for _, workflow := range listResp.Payload.Items {
+		log.Infof("Checking the shares of workflow %s", workflow.Name)
+		shareStatusParams := operations.NewGetWorkflowShareStatusParams()
+		shareStatusParams.SetAccessToken(&o.token)
+		shareStatusParams.SetWorkflowIDOrName(workflow.Name)
+		shareStatusResp, err := api.Operations.GetWorkflowShareStatus(shareStatusParams)
+		if err != nil {
+			return err
+		}
+		workflowShares, err := findExpiringShares(
+			workflow.Name,
+			shareStatusResp.Payload.SharedWith,
+			today,
+			o.days,
+		)
+		if err != nil {
+			return err
+		}
+		shares = append(shares, workflowShares...)
+	}
+	sort.SliceStable(shares, func(i, j int) bool {
+		return shares[i].DaysLeft < shares[j].DaysLeft
+	})
+
+	out := cmd.OutOrStdout()
+	if o.jsonOutput {
+		return displayer.DisplayJsonOutput(shares, out)
+	}
+	if len(shares) == 0 {
+		displayer.DisplayMessage(
+			fmt.Sprintf("No shares expire within the next %d days.", o.days),
+			displayer.Info,
+			false,
+			out,
+		)
+		return nil
+	}
+
+	var rows [][]string
+	for _, share := range shares {
+		rows = append(rows, []string{
+			share.Workflow,
+			share.UserEmail,
+			share.ValidUntil,
+			formatDaysLeft(share.DaysLeft),
+		})
+	}
+	displayer.DisplayTable(
+		[]string{"workflow", "user_email", "valid_until", "expires"},
+		rows,
+		out,
+	)
+	return nil
+}
+
+// findExpiringShares returns the shares of the workflow that expired or expire within the given days from today.
+func findExpiringShares(
+	workflow string,
+	sharedWith []*operations.GetWorkflowShareStatusOKBodySharedWithItems0,
+	today time.Time,
+	days int,
+) ([]expiringShare, error) {
+	var shares []expiringShare
+	for _, share := range sharedWith {
+		if share.ValidUntil == nil || *share.ValidUntil == "" {
+			continue
+		}
+		validUntil, err := parseShareDate(*share.ValidUntil)
+		if err != nil {
+			return nil, err
+		}
+		daysLeft := int(validUntil.Truncate(24*time.Hour).Sub(today).Hours() / 24)
+		if daysLeft > days {
+			continue
+		}
+		shares = append(shares, expiringShare{
+			Workflow:   workflow,
+			UserEmail:  share.UserEmail,
+			ValidUntil: *share.ValidUntil,
+			DaysLeft:   daysLeft,
+		})
+	}
+	return shares, nil
+}
+
+// parseShareDate parses the expiry date of a share, with or without time.
+func parseShareDate(date string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02"} {
+		if parsed, err := time.Parse(layout, date); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid share expiry date '%s'", date)
+}
+
+// formatDaysLeft describes when a share expires, given the number of days left.
+func formatDaysLeft(daysLeft int) string {
+	switch {
+	case daysLeft < 0:
+		return "expired"
+	case daysLeft == 0:
+		return "today"
+	case daysLeft == 1:
+		return "in 1 day"
+	default:
+		return fmt.Sprintf("in %d days", daysLeft)
+	}
+}
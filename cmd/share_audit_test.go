@@ -0,0 +1,91 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestShareAudit(t *testing.T) {
+	serverResponses := map[string]ServerResponse{
+		listServerPath: {
+			statusCode:   http.StatusOK,
+			responseFile: "list.json",
+		},
+		fmt.Sprintf(shareStatusPathTemplate, "my_workflow.23"): {
+			statusCode:   http.StatusOK,
+			responseFile: "share_status_expired.json",
+		},
+		fmt.Sprintf(shareStatusPathTemplate, "my_workflow2.12"): {
+			statusCode:   http.StatusOK,
+			responseFile: "share_status_future.json",
+		},
+	}
+	tests := map[string]TestCmdParams{
+		"default": {
+			serverResponses: serverResponses,
+			expected: []string{
+				"WORKFLOW", "USER_EMAIL", "VALID_UNTIL", "EXPIRES",
+				"my_workflow.23", "bob@cern.ch", "2020-01-31T00:00:00", "expired",
+			},
+			unwanted: []string{"cecile@cern.ch", "dan@cern.ch"},
+		},
+		"long period": {
+			serverResponses: serverResponses,
+			args:            []string{"--days", "100000"},
+			expected: []string{
+				"bob@cern.ch", "expired",
+				"my_workflow2.12", "dan@cern.ch", "2099-12-31T00:00:00", "days",
+			},
+			unwanted: []string{"cecile@cern.ch"},
+		},
+		"json": {
+			serverResponses: serverResponses,
+			args:            []string{"--json"},
+			expected: []string{
+				"\"workflow\": \"my_workflow.23\"",
+				"\"user_email\": \"bob@cern.ch\"",
+				"\"days_left\": -",
+			},
+		},
+		"no shares": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "common_empty.json",
+				},
+			},
+			args:     []string{"--days", "7"},
+			expected: []string{"No shares expire within the next 7 days."},
+		},
+		"invalid days": {
+			args:      []string{"--days", "-1"},
+			expected:  []string{"invalid value for '--days': must be a positive number"},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "share-audit"
+			testCmdRun(t, params)
+		})
+	}
+}
+
+func TestFormatDaysLeft(t *testing.T) {
+	tests := map[int]string{-3: "expired", 0: "today", 1: "in 1 day", 12: "in 12 days"}
+	for daysLeft, want := range tests {
+		if got := formatDaysLeft(daysLeft); got != want {
+			t.Errorf("Expected '%s' for %d days, got '%s'", want, daysLeft, got)
+		}
+	}
+}
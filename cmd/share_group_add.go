@@ -0,0 +1,104 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/usergroups"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const shareGroupAddDesc = `Add users to a local sharing group.
+
+The ` + "`share-group-add`" + ` command adds users to a named group, creating the group
+if needed. Groups are stored locally and can be used instead of the user emails
+in the ` + "`share-add`" + ` and ` + "`share-remove`" + ` commands by prefixing their
+name with @.
+
+Examples:
+
+  $ reana-client share-group-add higgs-team --user bob@cern.ch
+  --user cecile@cern.ch
+
+  $ reana-client share-add -w myanalysis.42 --user @higgs-team
+`
+
+type shareGroupAddOptions struct {
+	group string
+	users []string
+}
+
+// newShareGroupAddCmd creates a command to add users to a local sharing group.
+func newShareGroupAddCmd() *cobra.Command {
+	o := &shareGroupAddOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "share-group-add NAME",
+		Short: "Add users to a local sharing group.",
+		Long:  shareGroupAddDesc,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.group = strings.TrimPrefix(args[0], usergroups.Prefix)
+			if err := usergroups.ValidateName(o.group); err != nil {
+				return err
+			}
+			if len(o.users) == 0 {
+				return fmt.Errorf(
+					"at least one of the options: 'user' is required\n%s",
+					cmd.UsageString(),
+				)
+			}
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringSliceVarP(
+		&o.users,
+		"user",
+		"u",
+		[]string{},
+		"Users to add to the group.",
+	)
+
+	return cmd
+}
+
+func (o *shareGroupAddOptions) run(cmd *cobra.Command) error {
+	groups, path, err := loadUserGroups()
+	if err != nil {
+		return err
+	}
+	for _, user := range o.users {
+		if strings.HasPrefix(user, usergroups.Prefix) {
+			return fmt.Errorf("groups cannot contain other groups, '%s' is not a user email", user)
+		}
+	}
+
+	groups.Add(o.group, o.users)
+	if err := usergroups.Save(path, groups); err != nil {
+		return err
+	}
+
+	displayer.DisplayMessage(
+		fmt.Sprintf(
+			"Group %s%s now contains %s",
+			usergroups.Prefix,
+			o.group,
+			strings.Join(groups[o.group], ", "),
+		),
+		displayer.Success,
+		false,
+		cmd.OutOrStdout(),
+	)
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/usergroups"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const shareGroupListDesc = `List the local sharing groups.
+
+The ` + "`share-group-list`" + ` command lists the local sharing groups and their
+members.
+
+Examples:
+
+  $ reana-client share-group-list
+`
+
+type shareGroupListOptions struct {
+	jsonOutput bool
+}
+
+// newShareGroupListCmd creates a command to list the local sharing groups.
+func newShareGroupListCmd() *cobra.Command {
+	o := &shareGroupListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "share-group-list",
+		Short: "List the local sharing groups.",
+		Long:  shareGroupListDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
+
+	return cmd
+}
+
+func (o *shareGroupListOptions) run(cmd *cobra.Command) error {
+	groups, _, err := loadUserGroups()
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if o.jsonOutput {
+		return displayer.DisplayJsonOutput(groups, out)
+	}
+	if len(groups) == 0 {
+		displayer.DisplayMessage(
+			"There are no sharing groups. Create one with share-group-add.",
+			displayer.Info,
+			false,
+			out,
+		)
+		return nil
+	}
+
+	var rows [][]string
+	for _, name := range groups.Names() {
+		rows = append(rows, []string{
+			usergroups.Prefix + name,
+			strings.Join(groups[name], ", "),
+		})
+	}
+	displayer.DisplayTable([]string{"group", "users"}, rows, out)
+	return nil
+}
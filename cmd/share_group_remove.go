@@ -0,0 +1,88 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/usergroups"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const shareGroupRemoveDesc = `Remove users from a local sharing group.
+
+The ` + "`share-group-remove`" + ` command removes the given users from a local
+sharing group. If no users are given, the whole group is removed. Workflows
+already shared with the members of the group remain shared.
+
+Examples:
+
+  $ reana-client share-group-remove higgs-team --user bob@cern.ch
+
+  $ reana-client share-group-remove higgs-team
+`
+
+type shareGroupRemoveOptions struct {
+	group string
+	users []string
+}
+
+// newShareGroupRemoveCmd creates a command to remove users from a local sharing group.
+func newShareGroupRemoveCmd() *cobra.Command {
+	o := &shareGroupRemoveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "share-group-remove NAME",
+		Short: "Remove users from a local sharing group.",
+		Long:  shareGroupRemoveDesc,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.group = strings.TrimPrefix(args[0], usergroups.Prefix)
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringSliceVarP(
+		&o.users,
+		"user",
+		"u",
+		[]string{},
+		"Users to remove from the group. Removes the whole group if not given.",
+	)
+
+	return cmd
+}
+
+func (o *shareGroupRemoveOptions) run(cmd *cobra.Command) error {
+	groups, path, err := loadUserGroups()
+	if err != nil {
+		return err
+	}
+	if err := groups.Remove(o.group, o.users); err != nil {
+		return err
+	}
+	if err := usergroups.Save(path, groups); err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Group %s%s was removed", usergroups.Prefix, o.group)
+	if members, exists := groups[o.group]; exists {
+		message = fmt.Sprintf(
+			"Group %s%s now contains %s",
+			usergroups.Prefix,
+			o.group,
+			strings.Join(members, ", "),
+		)
+	}
+	displayer.DisplayMessage(message, displayer.Success, false, cmd.OutOrStdout())
+	return nil
+}
@@ -0,0 +1,46 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"reanahub/reana-client-go/pkg/usergroups"
+	"strings"
+)
+
+// loadUserGroups returns the local user groups and the path of the file where they are stored.
+func loadUserGroups() (usergroups.Groups, string, error) {
+	path, err := usergroups.DefaultPath()
+	if err != nil {
+		return nil, "", err
+	}
+	groups, err := usergroups.Load(path)
+	if err != nil {
+		return nil, "", err
+	}
+	return groups, path, nil
+}
+
+// expandUserGroups replaces the local group names in users, such as @higgs-team, by their members.
+func expandUserGroups(users []string) ([]string, error) {
+	hasGroups := false
+	for _, user := range users {
+		if strings.HasPrefix(user, usergroups.Prefix) {
+			hasGroups = true
+		}
+	}
+	if !hasGroups {
+		return users, nil
+	}
+
+	groups, _, err := loadUserGroups()
+	if err != nil {
+		return nil, err
+	}
+	return groups.Expand(users)
+}
@@ -0,0 +1,117 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestShareGroups(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	steps := []struct {
+		name      string
+		args      []string
+		expected  []string
+		unwanted  []string
+		wantError bool
+	}{
+		{
+			name:     "list without groups",
+			args:     []string{"share-group-list"},
+			expected: []string{"There are no sharing groups."},
+		},
+		{
+			name:     "create group",
+			args:     []string{"share-group-add", "@higgs-team", "-u", "cecile@cern.ch", "-u", "bob@cern.ch"},
+			expected: []string{"Group @higgs-team now contains bob@cern.ch, cecile@cern.ch"},
+		},
+		{
+			name:     "add to group",
+			args:     []string{"share-group-add", "higgs-team", "--user", "dan@cern.ch"},
+			expected: []string{"Group @higgs-team now contains bob@cern.ch, cecile@cern.ch, dan@cern.ch"},
+		},
+		{
+			name:     "create another group",
+			args:     []string{"share-group-add", "reviewers", "--user", "alice@cern.ch"},
+			expected: []string{"Group @reviewers now contains alice@cern.ch"},
+		},
+		{
+			name: "list groups",
+			args: []string{"share-group-list"},
+			expected: []string{
+				"GROUP", "USERS",
+				"@higgs-team", "bob@cern.ch, cecile@cern.ch, dan@cern.ch",
+				"@reviewers", "alice@cern.ch",
+			},
+		},
+		{
+			name:     "remove from group",
+			args:     []string{"share-group-remove", "higgs-team", "--user", "bob@cern.ch"},
+			expected: []string{"Group @higgs-team now contains cecile@cern.ch, dan@cern.ch"},
+		},
+		{
+			name:     "remove group",
+			args:     []string{"share-group-remove", "@reviewers"},
+			expected: []string{"Group @reviewers was removed"},
+		},
+		{
+			name:     "list groups as json",
+			args:     []string{"share-group-list", "--json"},
+			expected: []string{"\"higgs-team\": [", "\"cecile@cern.ch\""},
+			unwanted: []string{"reviewers", "bob@cern.ch"},
+		},
+		{
+			name:      "remove missing group",
+			args:      []string{"share-group-remove", "reviewers"},
+			expected:  []string{"group @reviewers does not exist"},
+			wantError: true,
+		},
+		{
+			name:      "invalid group name",
+			args:      []string{"share-group-add", "higgs team", "--user", "bob@cern.ch"},
+			expected:  []string{"invalid group name 'higgs team'"},
+			wantError: true,
+		},
+		{
+			name:      "nested group",
+			args:      []string{"share-group-add", "all", "--user", "@higgs-team"},
+			expected:  []string{"groups cannot contain other groups"},
+			wantError: true,
+		},
+		{
+			name:      "missing users",
+			args:      []string{"share-group-add", "empty"},
+			expected:  []string{"at least one of the options: 'user' is required"},
+			wantError: true,
+		},
+	}
+
+	// The steps share the same groups file, so they must run in order.
+	for _, step := range steps {
+		output, err := ExecuteCommand(NewRootCmd(), step.args...)
+		if err != nil {
+			output += err.Error()
+		}
+		if step.wantError != (err != nil) {
+			t.Fatalf("%s: expected error %v, got '%v'", step.name, step.wantError, err)
+		}
+		for _, expected := range step.expected {
+			if !strings.Contains(output, expected) {
+				t.Errorf("%s: expected '%s' in output, got '%s'", step.name, expected, output)
+			}
+		}
+		for _, unwanted := range step.unwanted {
+			if strings.Contains(output, unwanted) {
+				t.Errorf("%s: expected '%s' not to be in output, got '%s'", step.name, unwanted, output)
+			}
+		}
+	}
+}
@@ -0,0 +1,122 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/displayer"
+
+	"github.com/spf13/cobra"
+)
+
+const shareListDesc = `List the users you share workflows with.
+
+The ` + "`share-list`" + ` command lists the users you share workflows with, and the
+users who share workflows with you.
+
+Examples:
+
+  $ reana-client share-list
+
+  $ reana-client share-list --json
+`
+
+type shareListOptions struct {
+	token      string
+	jsonOutput bool
+}
+
+// shareList users you share workflows with and users who share workflows with you.
+type shareList struct {
+	SharedByYou   []string `json:"shared_by_you"`
+	SharedWithYou []string `json:"shared_with_you"`
+}
+
+// newShareListCmd creates a command to list the users you share workflows with.
+func newShareListCmd() *cobra.Command {
+	o := &shareListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "share-list",
+		Short: "List the users you share workflows with.",
+		Long:  shareListDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(
+		&o.token,
+		"access-token",
+		"t",
+		"",
+		"Access token of the current user.",
+	)
+	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
+
+	return cmd
+}
+
+func (o *shareListOptions) run(cmd *cobra.Command) error {
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	if err := requireServerFeature(api, o.token, "sharing", "share-list"); err != nil {
+		return err
+	}
+
+	youSharedWithParams := operations.NewGetUsersYouSharedWithParams()
+	youSharedWithParams.SetAccessToken(&o.token)
+	youSharedWithResp, err := api.Operations.GetUsersYouSharedWith(youSharedWithParams)
+	if err != nil {
+		return err
+	}
+	sharedWithYouParams := operations.NewGetUsersSharedWithYouParams()
+	sharedWithYouParams.SetAccessToken(&o.token)
+	sharedWithYouResp, err := api.Operations.GetUsersSharedWithYou(sharedWithYouParams)
+	if err != nil {
+		return err
+	}
+
+	users := shareList{SharedByYou: []string{}, SharedWithYou: []string{}}
+	for _, user := range youSharedWithResp.Payload.Users {
+		users.SharedByYou = append(users.SharedByYou, user.Email)
+	}
+	for _, user := range sharedWithYouResp.Payload.Users {
+		users.SharedWithYou = append(users.SharedWithYou, user.Email)
+	}
+
+	out := cmd.OutOrStdout()
+	if o.jsonOutput {
+		return displayer.DisplayJsonOutput(users, out)
+	}
+	if len(users.SharedByYou) == 0 && len(users.SharedWithYou) == 0 {
+		displayer.DisplayMessage(
+			"You do not share workflows with anyone, and nobody shares workflows with you.",
+			displayer.Info,
+			false,
+			out,
+		)
+		return nil
+	}
+
+	var rows [][]string
+	for _, email := range users.SharedByYou {
+		rows = append(rows, []string{email, "you share workflows with this user"})
+	}
+	for _, email := range users.SharedWithYou {
+		rows = append(rows, []string{email, "this user shares workflows with you"})
+	}
+	displayer.DisplayTable([]string{"user_email", "sharing"}, rows, out)
+	return nil
+}
@@ -0,0 +1,90 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"net/http"
+	"testing"
+)
+
+var youSharedWithServerPath = "/api/users/you-shared-with"
+var sharedWithYouServerPath = "/api/users/shared-with-you"
+
+func TestShareList(t *testing.T) {
+	tests := map[string]TestCmdParams{
+		"default": {
+			serverResponses: map[string]ServerResponse{
+				youSharedWithServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "share_list_you_shared_with.json",
+				},
+				sharedWithYouServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "share_list_shared_with_you.json",
+				},
+			},
+			expected: []string{
+				"USER_EMAIL", "SHARING",
+				"bob@cern.ch", "cecile@cern.ch", "you share workflows with this user",
+				"dan@cern.ch", "this user shares workflows with you",
+			},
+		},
+		"json": {
+			serverResponses: map[string]ServerResponse{
+				youSharedWithServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "share_list_you_shared_with.json",
+				},
+				sharedWithYouServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "common_empty.json",
+				},
+			},
+			args: []string{"--json"},
+			expected: []string{
+				"\"shared_by_you\": [", "\"bob@cern.ch\"",
+				"\"shared_with_you\": []",
+			},
+		},
+		"nothing shared": {
+			serverResponses: map[string]ServerResponse{
+				youSharedWithServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "common_empty.json",
+				},
+				sharedWithYouServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "common_empty.json",
+				},
+			},
+			expected: []string{
+				"You do not share workflows with anyone, and nobody shares workflows with you.",
+			},
+		},
+		"not supported by old server": {
+			serverResponses: map[string]ServerResponse{
+				pingServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "ping.json",
+				},
+			},
+			expected: []string{
+				"share-list is not supported by server 0.9, it requires REANA 0.95 or newer",
+			},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "share-list"
+			testCmdRun(t, params)
+		})
+	}
+}
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2023, 2024, 2025 CERN.
+Copyright (C) 2023, 2024, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -72,7 +72,8 @@ func newShareRemoveCmd() *cobra.Command {
 		"user",
 		"u",
 		[]string{},
-		`Users to unshare the workflow with.`,
+		`Users to unshare the workflow with. Local
+	sharing groups can be given as @name.`,
 	)
 	// Remove -h shorthand
 	cmd.PersistentFlags().BoolP("help", "h", false, "Help for share-remove")
@@ -85,15 +86,23 @@ func (o *shareRemoveOptions) run(cmd *cobra.Command) error {
 	shareRemoveParams.SetAccessToken(&o.token)
 	shareRemoveParams.SetWorkflowIDOrName(o.workflow)
 
+	users, err := expandUserGroups(o.users)
+	if err != nil {
+		return err
+	}
+
 	api, err := client.ApiClient()
 	if err != nil {
 		return err
 	}
+	if err := requireServerFeature(api, o.token, "sharing", "share-remove"); err != nil {
+		return err
+	}
 
 	shareErrors := []string{}
 	sharedUsers := []string{}
 
-	for _, user := range o.users {
+	for _, user := range users {
 		log.Infof("Unsharing workflow %s with user %s", o.workflow, user)
 
 		shareRemoveParams.SetUserEmailToUnshareWith(user)
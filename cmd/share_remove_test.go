@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2023, 2024 CERN.
+Copyright (C) 2023, 2024, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it under the terms
 of the MIT License; see LICENSE file for more details.
@@ -11,6 +11,7 @@ package cmd
 import (
 	"fmt"
 	"net/http"
+	"reanahub/reana-client-go/pkg/usergroups"
 	"testing"
 )
 
@@ -55,3 +56,28 @@ func TestShareRemove(t *testing.T) {
 		})
 	}
 }
+
+func TestShareRemoveWithGroups(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	path, err := usergroups.DefaultPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups := usergroups.Groups{"higgs-team": {"bob@cern.ch", "cecile@cern.ch"}}
+	if err := usergroups.Save(path, groups); err != nil {
+		t.Fatal(err)
+	}
+
+	testCmdRun(t, TestCmdParams{
+		cmd: "share-remove",
+		serverResponses: map[string]ServerResponse{
+			fmt.Sprintf(shareRemovePathTemplate, "my_workflow"): {
+				statusCode: http.StatusOK,
+			},
+		},
+		args: []string{"-w", "my_workflow", "--user", "@higgs-team"},
+		expected: []string{
+			"my_workflow is no longer shared with bob@cern.ch, cecile@cern.ch",
+		},
+	})
+}
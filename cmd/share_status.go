@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2023, 2024, 2025 CERN.
+Copyright (C) 2023, 2024, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -28,9 +28,11 @@ const shareStatusDesc = `Show with whom a workflow is shared.
 The ` + "`share-status`" + ` command allows for checking with whom a workflow is
 shared.
 
-Example:
+Examples:
 
   $ reana-client share-status -w myanalysis.42
+
+  $ reana-client share-status -w myanalysis.42 --sort -valid_until
 `
 
 type shareStatusOptions struct {
@@ -38,6 +40,7 @@ type shareStatusOptions struct {
 	workflow      string
 	formatFilters []string
 	jsonOutput    bool
+	sortOptions
 }
 
 // newShareStatusCmd creates a command to show with whom a workflow is shared.
@@ -77,14 +80,23 @@ func newShareStatusCmd() *cobra.Command {
 		shareStatusFormatFlagDesc,
 	)
 	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
+	addSortFlag(f, &o.sortOptions, []string{})
 
 	// Remove -h shorthand
 	cmd.PersistentFlags().BoolP("help", "h", false, "Help for share-status")
 
+	registerFlagCompletion(cmd, "format", completeColumns(shareStatusColumns))
+	registerFlagCompletion(cmd, "sort", completeSortColumns(shareStatusColumns))
+
 	return cmd
 }
 
 func (o *shareStatusOptions) run(cmd *cobra.Command) error {
+	sortKeys, err := o.sortKeys()
+	if err != nil {
+		return err
+	}
+
 	shareStatusParams := operations.NewGetWorkflowShareStatusParams()
 	shareStatusParams.SetAccessToken(&o.token)
 	shareStatusParams.SetWorkflowIDOrName(o.workflow)
@@ -93,6 +105,9 @@ func (o *shareStatusOptions) run(cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
+	if err := requireServerFeature(api, o.token, "sharing", "share-status"); err != nil {
+		return err
+	}
 	shareStatusResp, err := api.Operations.GetWorkflowShareStatus(
 		shareStatusParams,
 	)
@@ -115,13 +130,12 @@ func (o *shareStatusOptions) run(cmd *cobra.Command) error {
 		o.formatFilters,
 		true,
 	)
-	header := []string{"user_email", "valid_until"}
-
 	err = displayShareStatusPayload(
 		cmd,
 		shareStatusResp.Payload,
-		header,
+		shareStatusColumns,
 		parsedFormatFilters,
+		sortKeys,
 		o.jsonOutput,
 	)
 	return err
@@ -132,6 +146,7 @@ func displayShareStatusPayload(
 	payload *operations.GetWorkflowShareStatusOKBody,
 	header []string,
 	formatFilters []formatter.FormatFilter,
+	sortKeys []formatter.SortKey,
 	jsonOutput bool,
 ) error {
 	var df dataframe.DataFrame
@@ -155,7 +170,11 @@ func displayShareStatusPayload(
 		df = df.CBind(dataframe.New(colSeries))
 	}
 
-	df, err := formatter.FormatDataFrame(df, formatFilters)
+	df, err := formatter.SortDataFrame(df, sortKeys)
+	if err != nil {
+		return err
+	}
+	df, err = formatter.FormatDataFrame(df, formatFilters)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2023, 2025 CERN.
+Copyright (C) 2023, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it under the terms
 of the MIT License; see LICENSE file for more details.
@@ -33,6 +33,40 @@ func TestShareStatus(t *testing.T) {
 				),
 			},
 		},
+		"sorted": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(shareStatusPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "share_status_expired.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "--sort", "-user_email"},
+			expected: []string{"cecile@cern.ch   -                  \nbob@cern.ch"},
+		},
+		"invalid sort column": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(shareStatusPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "share_status_expired.json",
+				},
+			},
+			args:      []string{"-w", workflowName, "--sort", "created"},
+			expected:  []string{"column 'created' does not exist"},
+			wantError: true,
+		},
+		"not supported by old server": {
+			serverResponses: map[string]ServerResponse{
+				pingServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "ping.json",
+				},
+			},
+			args: []string{"-w", workflowName},
+			expected: []string{
+				"share-status is not supported by server 0.9, it requires REANA 0.95 or newer",
+			},
+			wantError: true,
+		},
 	}
 
 	for name, params := range tests {
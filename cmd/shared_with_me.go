@@ -0,0 +1,231 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/workflows"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const sharedWithMeDesc = `List the workflows other users shared with you.
+
+The ` + "`shared-with-me`" + ` command lists the users who shared workflows with you,
+together with the workflows each of them shared. Use ` + "`--owner`" + ` to only list
+the workflows shared by a given user.
+
+The files of these workflows can be listed and downloaded by passing the owner
+with ` + "`--shared-by`" + ` to the ` + "`ls`" + ` and ` + "`download`" + ` commands, and copied to
+your workspaces by passing it with ` + "`--from-shared`" + ` to the ` + "`cp`" + ` command.
+
+Examples:
+
+  $ reana-client shared-with-me
+
+  $ reana-client shared-with-me --owner alice@cern.ch
+
+  $ reana-client ls -w myanalysis.42 --shared-by alice@cern.ch
+`
+
+type sharedWithMeOptions struct {
+	token      string
+	owner      string
+	jsonOutput bool
+}
+
+// sharedWorkflow a workflow shared with the current user.
+type sharedWorkflow struct {
+	Owner     string `json:"owner"`
+	Name      string `json:"name"`
+	RunNumber string `json:"run_number"`
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	Created   string `json:"created"`
+}
+
+// newSharedWithMeCmd creates a command to list the workflows other users shared with you.
+func newSharedWithMeCmd() *cobra.Command {
+	o := &sharedWithMeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "shared-with-me",
+		Short: "List the workflows other users shared with you.",
+		Long:  sharedWithMeDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(
+		&o.token,
+		"access-token",
+		"t",
+		"",
+		"Access token of the current user.",
+	)
+	f.StringVar(
+		&o.owner,
+		"owner",
+		"",
+		"Only list the workflows shared by the given user.",
+	)
+	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
+
+	return cmd
+}
+
+func (o *sharedWithMeOptions) run(cmd *cobra.Command) error {
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	if err := requireServerFeature(api, o.token, "sharing", "shared-with-me"); err != nil {
+		return err
+	}
+
+	owners := []string{o.owner}
+	if o.owner == "" {
+		sharedWithYouParams := operations.NewGetUsersSharedWithYouParams()
+		sharedWithYouParams.SetAccessToken(&o.token)
+		sharedWithYouResp, err := api.Operations.GetUsersSharedWithYou(sharedWithYouParams)
+		if err != nil {
+			return err
+		}
+		owners = nil
+		for _, user := range sharedWithYouResp.Payload.Users {
+			owners = append(owners, user.Email)
+		}
+	}
+
+	shared := []sharedWorkflow{}
+	for _, owner := range owners {
+		ownerWorkflows, err := getWorkflowsSharedBy(api, o.token, owner)
+		if err != nil {
+			return err
+		}
+		shared = append(shared, ownerWorkflows...)
+	}
+
+	out := cmd.OutOrStdout()
+	if o.jsonOutput {
+		return displayer.DisplayJsonOutput(shared, out)
+	}
+	if len(shared) == 0 {
+		message := "Nobody shared workflows with you."
+		if o.owner != "" {
+			message = fmt.Sprintf("%s did not share workflows with you.", o.owner)
+		}
+		displayer.DisplayMessage(message, displayer.Info, false, out)
+		return nil
+	}
+
+	var rows [][]string
+	for _, workflow := range shared {
+		rows = append(rows, []string{
+			workflow.Owner,
+			workflow.Name,
+			workflow.RunNumber,
+			workflow.ID,
+			workflow.Status,
+			workflow.Created,
+		})
+	}
+	displayer.DisplayTable(
+		[]string{"owner", "name", "run_number", "id", "status", "created"},
+		rows,
+		out,
+	)
+	cmd.Println(
+		"\nUse --shared-by <owner> with ls or download to access the files of these workflows.",
+	)
+	return nil
+}
+
+// getWorkflowsSharedBy returns the workflows the given user shared with the current user.
+func getWorkflowsSharedBy(
+	api *client.API,
+	token, owner string,
+) ([]sharedWorkflow, error) {
+	log.Infof("Listing the workflows shared by %s", owner)
+	listParams := operations.NewGetWorkflowsParams()
+	listParams.SetAccessToken(&token)
+	listParams.SetType("batch")
+	listParams.SetSharedBy(&owner)
+	listParams.SetStatus(config.GetRunStatuses(false))
+	listResp, err := api.Operations.GetWorkflows(listParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var shared []sharedWorkflow
+	for _, workflow := range listResp.Payload.Items {
+		name, runNumber := workflows.GetNameAndRunNumber(workflow.Name)
+		workflowOwner := workflow.OwnerEmail
+		if workflowOwner == "" {
+			workflowOwner = owner
+		}
+		shared = append(shared, sharedWorkflow{
+			Owner:     workflowOwner,
+			Name:      name,
+			RunNumber: runNumber,
+			ID:        workflow.ID,
+			Status:    workflow.Status,
+			Created:   workflow.Created,
+		})
+	}
+	return shared, nil
+}
+
+// getSharedWorkflowID returns the UUID of the workflow that the owner shared with the current user,
+// after checking that the server supports sharing.
+func getSharedWorkflowID(token, owner, workflow string) (string, error) {
+	api, err := client.ApiClient()
+	if err != nil {
+		return "", err
+	}
+	if err := requireServerFeature(api, token, "sharing", "--shared-by"); err != nil {
+		return "", err
+	}
+	return resolveSharedWorkflow(api, token, owner, workflow)
+}
+
+// resolveSharedWorkflow returns the UUID of the workflow that the owner shared with the current user.
+// The workflow can be given by UUID, by name and run number, or by name only to select its latest run.
+func resolveSharedWorkflow(api *client.API, token, owner, workflow string) (string, error) {
+	shared, err := getWorkflowsSharedBy(api, token, owner)
+	if err != nil {
+		return "", err
+	}
+
+	var latest *sharedWorkflow
+	for i, candidate := range shared {
+		fullName := candidate.Name
+		if candidate.RunNumber != "" {
+			fullName += "." + candidate.RunNumber
+		}
+		if candidate.ID == workflow || fullName == workflow {
+			return candidate.ID, nil
+		}
+		if candidate.Name == workflow && (latest == nil || candidate.Created > latest.Created) {
+			latest = &shared[i]
+		}
+	}
+	if latest != nil {
+		return latest.ID, nil
+	}
+	return "", fmt.Errorf("workflow %s is not shared with you by %s", workflow, owner)
+}
@@ -0,0 +1,152 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSharedWithMe(t *testing.T) {
+	tests := map[string]TestCmdParams{
+		"default": {
+			serverResponses: map[string]ServerResponse{
+				sharedWithYouServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "share_list_shared_with_you.json",
+				},
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "shared_with_me_list.json",
+				},
+			},
+			expected: []string{
+				"OWNER", "NAME", "RUN_NUMBER", "ID", "STATUS", "CREATED",
+				"dan@cern.ch", "analysis", "3bd1f2a4-8c5e-4b1d-9f0a-6e2c7d8b9a01", "running",
+				"5f2e8c1b-7a4d-4e3f-8b2c-1d9e0f6a7b02", "finished",
+				"Use --shared-by <owner> with ls or download",
+			},
+		},
+		"owner": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "shared_with_me_list.json",
+				},
+			},
+			args: []string{"--owner", "dan@cern.ch", "--json"},
+			expected: []string{
+				"\"owner\": \"dan@cern.ch\"",
+				"\"name\": \"analysis\"",
+				"\"run_number\": \"2\"",
+			},
+		},
+		"nothing shared": {
+			serverResponses: map[string]ServerResponse{
+				sharedWithYouServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "common_empty.json",
+				},
+			},
+			expected: []string{"Nobody shared workflows with you."},
+		},
+		"owner without shared workflows": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "common_empty.json",
+				},
+			},
+			args:     []string{"--owner", "eve@cern.ch"},
+			expected: []string{"eve@cern.ch did not share workflows with you."},
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "shared-with-me"
+			testCmdRun(t, params)
+		})
+	}
+}
+
+func TestSharedWorkflowFiles(t *testing.T) {
+	latestRunID := "3bd1f2a4-8c5e-4b1d-9f0a-6e2c7d8b9a01"
+	firstRunID := "5f2e8c1b-7a4d-4e3f-8b2c-1d9e0f6a7b02"
+	tests := map[string]TestCmdParams{
+		"ls latest run": {
+			cmd: "ls",
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "shared_with_me_list.json",
+				},
+				fmt.Sprintf(lsPathTemplate, latestRunID): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+			},
+			args:     []string{"-w", "analysis", "--shared-by", "dan@cern.ch"},
+			expected: []string{"NAME", "SIZE", "LAST-MODIFIED"},
+		},
+		"ls given run": {
+			cmd: "ls",
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "shared_with_me_list.json",
+				},
+				fmt.Sprintf(lsPathTemplate, firstRunID): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+			},
+			args:     []string{"-w", "analysis.1", "--shared-by", "dan@cern.ch"},
+			expected: []string{"NAME", "SIZE", "LAST-MODIFIED"},
+		},
+		"ls workflow not shared": {
+			cmd: "ls",
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "shared_with_me_list.json",
+				},
+			},
+			args:      []string{"-w", "other.1", "--shared-by", "dan@cern.ch"},
+			expected:  []string{"workflow other.1 is not shared with you by dan@cern.ch"},
+			wantError: true,
+		},
+		"download": {
+			cmd: "download",
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "shared_with_me_list.json",
+				},
+				fmt.Sprintf(downloadServerPath, firstRunID, "results/data.txt"): {
+					statusCode:   http.StatusOK,
+					responseFile: "common_empty.json",
+					responseHeaders: map[string]string{
+						"Content-Disposition": `attachment; filename="results/data.txt"`,
+					},
+				},
+			},
+			args: []string{
+				"-w", firstRunID, "--shared-by", "dan@cern.ch", "-o", "-", "results/data.txt",
+			},
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			testCmdRun(t, params)
+		})
+	}
+}
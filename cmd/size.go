@@ -0,0 +1,65 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"reanahub/reana-client-go/pkg/datautils"
+	"reanahub/reana-client-go/pkg/validator"
+	"strconv"
+
+	"github.com/spf13/pflag"
+)
+
+const unitsFlagDesc = `Units of the human readable sizes: iec for powers
+of 1024 (KiB, MiB, GiB...) or si for powers of 1000
+(kB, MB, GB...).`
+
+// sizeOptions contains the values of the --human-readable, --bytes and --units flags.
+type sizeOptions struct {
+	humanReadable          bool
+	bytes                  bool
+	units                  string
+	humanReadableByDefault bool
+}
+
+// addSizeFlags adds the -h/--human-readable, --bytes and --units flags, which choose how sizes are displayed.
+// Sizes are displayed in bytes by default, unless humanReadableByDefault is true.
+func addSizeFlags(
+	f *pflag.FlagSet,
+	o *sizeOptions,
+	humanReadableDesc string,
+	humanReadableByDefault bool,
+) {
+	o.humanReadableByDefault = humanReadableByDefault
+	f.BoolVarP(&o.humanReadable, "human-readable", "h", false, humanReadableDesc)
+	f.BoolVar(&o.bytes, "bytes", false, "Show sizes as a number of bytes.")
+	f.StringVar(&o.units, "units", datautils.IECUnits, unitsFlagDesc)
+}
+
+// validateSizeFlags checks that --human-readable and --bytes are not used together and that --units is valid.
+func (o *sizeOptions) validateSizeFlags() error {
+	if o.humanReadable && o.bytes {
+		return errors.New("please provide either --human-readable or --bytes, not both")
+	}
+	return validator.ValidateChoice(o.units, datautils.ByteSizeUnits, "units")
+}
+
+// showHumanReadable returns whether sizes are displayed in human readable format.
+func (o *sizeOptions) showHumanReadable() bool {
+	return o.humanReadable || (o.humanReadableByDefault && !o.bytes)
+}
+
+// formatSize formats a number of bytes according to the --human-readable, --bytes and --units flags.
+func (o *sizeOptions) formatSize(bytes int64) string {
+	if o.showHumanReadable() {
+		return datautils.FormatByteSizeUnits(bytes, o.units)
+	}
+	return strconv.FormatInt(bytes, 10)
+}
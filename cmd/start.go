@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -10,6 +10,7 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"reanahub/reana-client-go/client"
 	"reanahub/reana-client-go/client/operations"
@@ -19,6 +20,7 @@ import (
 	"reanahub/reana-client-go/pkg/workflows"
 	"time"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 
 	"golang.org/x/exp/slices"
@@ -36,6 +38,12 @@ options using ` + "``-o``" + ` or ` + "``--options``" + `.The input parameters a
 options can be repetitive. For example, to disable caching for the Serial
 workflow engine, you can set ` + "``-o CACHE=off``" + `.
 
+Before starting the workflow, the secrets referenced in its specification are
+checked, and a warning is displayed for each one that does not exist. The
+workflow engine, the workspace and the Kubernetes resources requested by its
+steps are also checked against the server limits, and the workflow is not
+started if any of them is exceeded.
+
 Examples:
 
 $ reana-client start -w myanalysis.42 -p sleeptime=10 -p myparam=4
@@ -126,6 +134,11 @@ func (o *startOptions) run(cmd *cobra.Command) error {
 		}
 	}
 
+	err = checkWorkflowBeforeStart(api, o.token, o.workflow, cmd.OutOrStdout())
+	if err != nil {
+		return err
+	}
+
 	startParams := operations.NewStartWorkflowParams()
 	startParams.SetAccessToken(&o.token)
 	startParams.SetWorkflowIDOrName(o.workflow)
@@ -208,6 +221,45 @@ func validateStartOptionsAndParams(
 	return validatedOptions, validatedParams, nil
 }
 
+// checkWorkflowBeforeStart checks the specification of the workflow before it is started,
+// warning about missing secrets and reporting the violations of the server limits.
+// Failing to perform the checks does not prevent the workflow from being started,
+// but violations of the server limits do.
+func checkWorkflowBeforeStart(api *client.API, token, workflow string, out io.Writer) error {
+	specResp, err := workflows.GetWorkflowSpecification(token, workflow)
+	if err != nil {
+		log.Debugf("Could not retrieve the workflow specification: %v", err)
+		return nil
+	}
+	spec, err := workflows.SpecificationToMap(specResp.Specification)
+	if err != nil {
+		log.Debugf("Could not parse the workflow specification: %v", err)
+		return nil
+	}
+	if _, err := checkSecretReferences(api, token, spec, out); err != nil {
+		log.Debugf("Could not check the secrets referenced by the workflow: %v", err)
+	}
+
+	violations, err := checkServerLimits(api, token, spec, out)
+	if err != nil {
+		log.Debugf("Could not check the workflow against the server limits: %v", err)
+		return nil
+	}
+	if violations > 0 {
+		displayer.DisplayMessage(
+			fmt.Sprintf(
+				"Workflow %s cannot be started as it exceeds the server limits.",
+				workflow,
+			),
+			displayer.Error,
+			false,
+			out,
+		)
+		return config.ErrEmpty
+	}
+	return nil
+}
+
 // followWorkflowExecution follow the execution of the workflow, by calling the GetStatus endpoint periodically.
 // The interval used for the requests is dictated by config.CheckInterval.
 // If the workflow finishes successfully, this calls the ls command to display the workflow files' URLs.
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -17,6 +17,7 @@ import (
 
 var startPathTemplate = "/api/workflows/%s/start"
 var paramsPathTemplate = "/api/workflows/%s/parameters"
+var specPathTemplate = "/api/workflows/%s/specification"
 
 func TestStart(t *testing.T) {
 	// Deactivate the sleep used with the --follow flag
@@ -190,6 +191,80 @@ func TestStart(t *testing.T) {
 			},
 			wantError: true,
 		},
+		"missing secrets": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(startPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "start_success.json",
+				},
+				fmt.Sprintf(specPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "workflow_specification_secrets.json",
+				},
+				secretsListServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "secrets_list.json",
+				},
+			},
+			args: []string{"-w", workflowName},
+			expected: []string{
+				"Secret 'CERN_KEYTAB' is referenced as environment variable $CERN_KEYTAB but does not exist",
+				"Secret 'CERN_USER' is referenced as environment variable $CERN_USER but does not exist",
+				"Environment variable $MY_TOKEN is neither an input parameter nor a secret, it is possibly unset.",
+				workflowName + " is running",
+			},
+			unwanted: []string{"secret2", "HOME"},
+		},
+		"specification not available": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(startPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "start_success.json",
+				},
+				fmt.Sprintf(specPathTemplate, workflowName): {
+					statusCode:   http.StatusNotFound,
+					responseFile: "common_invalid_workflow.json",
+				},
+			},
+			args: []string{"-w", workflowName},
+			expected: []string{
+				workflowName + " is running",
+			},
+		},
+		"exceeds server limits": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(specPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "workflow_specification_limits.json",
+				},
+			},
+			args: []string{"-w", workflowName},
+			expected: []string{
+				"step 'gendata': kubernetes_job_timeout '600' exceeds the maximum allowed by the server '500'",
+				"step 'gendata': kubernetes_memory_limit '32Gi' exceeds the maximum allowed by the server '10Gi'",
+				"Workflow " + workflowName + " cannot be started as it exceeds the server limits.",
+			},
+			unwanted:  []string{"kubernetes_cpu_limit", "is running"},
+			wantError: true,
+		},
+		"server limits not available": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(startPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "start_success.json",
+				},
+				fmt.Sprintf(specPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "workflow_specification_limits.json",
+				},
+				infoServerPath: {
+					statusCode:   http.StatusInternalServerError,
+					responseFile: "common_internal_server_error.json",
+				},
+			},
+			args:     []string{"-w", workflowName},
+			expected: []string{workflowName + " is running"},
+		},
 		"follow stopped": {
 			serverResponses: map[string]ServerResponse{
 				fmt.Sprintf(startPathTemplate, workflowName): {
@@ -236,6 +311,19 @@ func TestStart(t *testing.T) {
 	for name, params := range tests {
 		t.Run(name, func(t *testing.T) {
 			params.cmd = "start"
+			specPath := fmt.Sprintf(specPathTemplate, workflowName)
+			if _, ok := params.serverResponses[specPath]; !ok {
+				params.serverResponses[specPath] = ServerResponse{
+					statusCode:   http.StatusOK,
+					responseFile: "workflow_specification.json",
+				}
+			}
+			if _, ok := params.serverResponses[infoServerPath]; !ok {
+				params.serverResponses[infoServerPath] = ServerResponse{
+					statusCode:   http.StatusOK,
+					responseFile: "info_big.json",
+				}
+			}
 			testCmdRun(t, params)
 		})
 	}
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2024, 2025 CERN.
+Copyright (C) 2022, 2024, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -101,6 +101,8 @@ func newStatusCmd() *cobra.Command {
 In case a workflow is in progress, its duration as of now will be shown.`,
 	)
 
+	registerFlagCompletion(cmd, "format", completeColumns(statusColumns))
+
 	return cmd
 }
 
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -26,15 +26,23 @@ that soft-stopping of the workflow is currently not supported. This command
 should be therefore used with care, only if you are absolutely sure that
 there is no point in continuing the running the workflow.
 
+Instead of a single workflow, you can stop all the running workflows matching
+a selection by using the ` + "``--filter``" + `, ` + "``--older-than``" + ` and ` + "``--name-prefix``" + `
+flags. The selected workflows are displayed and a confirmation is asked
+before stopping them, unless ` + "``--yes``" + ` is specified.
+
 Example:
 
   $ reana-client stop -w myanalysis.42 --force
+
+  $ reana-client stop --name-prefix test- --older-than 12h --force
 `
 
 type stopOptions struct {
 	token    string
 	workflow string
 	force    bool
+	selector bulkSelector
 }
 
 // newStopCmd creates a command to stop a running workflow.
@@ -47,6 +55,12 @@ func newStopCmd() *cobra.Command {
 		Long:  stopDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.selector.validate(cmd.Flags()); err != nil {
+				return err
+			}
+			if isBulkSelection(cmd.Flags()) {
+				return o.runBulk(cmd)
+			}
 			return o.run(cmd)
 		},
 	}
@@ -72,16 +86,14 @@ func newStopCmd() *cobra.Command {
 		false,
 		"Stop a workflow without waiting for jobs to finish.",
 	)
+	o.selector.addFlags(f)
 
 	return cmd
 }
 
 func (o *stopOptions) run(cmd *cobra.Command) error {
-	if !o.force {
-		return fmt.Errorf(
-			"graceful stop not implemented yet. If you really want to stop your " +
-				"workflow without waiting for jobs to finish use: --force option",
-		)
+	if err := o.validateForce(); err != nil {
+		return err
 	}
 
 	log.Infof("Sending a request to stop workflow %s", o.workflow)
@@ -109,3 +121,42 @@ func (o *stopOptions) run(cmd *cobra.Command) error {
 	)
 	return nil
 }
+
+// runBulk stops all the workflows matching the selection flags.
+// Unless a status filter is given, only the workflows in progress are selected.
+func (o *stopOptions) runBulk(cmd *cobra.Command) error {
+	if err := o.validateForce(); err != nil {
+		return err
+	}
+
+	targets, err := o.selector.resolve(
+		o.token,
+		[]string{"running", "queued", "pending"},
+	)
+	if err != nil {
+		return err
+	}
+	return o.selector.runBulkAction(
+		cmd,
+		targets,
+		"stop",
+		func(workflow string) (string, error) {
+			err := workflows.UpdateStatus(o.token, workflow, "stop", false, false)
+			if err != nil {
+				return "", err
+			}
+			return "stopped", nil
+		},
+	)
+}
+
+// validateForce returns an error if --force was not given, since graceful stop is not supported.
+func (o *stopOptions) validateForce() error {
+	if !o.force {
+		return fmt.Errorf(
+			"graceful stop not implemented yet. If you really want to stop your " +
+				"workflow without waiting for jobs to finish use: --force option",
+		)
+	}
+	return nil
+}
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022 CERN.
+Copyright (C) 2022, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -40,6 +40,29 @@ func TestStop(t *testing.T) {
 			},
 			wantError: true,
 		},
+		"bulk stop": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+				fmt.Sprintf(stopPathTemplate, "my_workflow2.12"): {
+					statusCode:   http.StatusOK,
+					responseFile: "stop_success.json",
+				},
+			},
+			args: []string{"--name-prefix", "my_workflow2", "--force", "--yes"},
+			expected: []string{
+				"my_workflow2.12", "stopped",
+			},
+		},
+		"bulk graceful stop error": {
+			args: []string{"--name-prefix", "my_workflow2"},
+			expected: []string{
+				"graceful stop not implemented yet",
+			},
+			wantError: true,
+		},
 	}
 
 	for name, params := range tests {
@@ -0,0 +1,94 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"reanahub/reana-client-go/pkg/formatter"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+const columnsFlagDesc = `Set the columns to display, which can be renamed,
+e.g. name:Workflow, or computed from other columns,
+e.g. duration_h=duration/3600 or size_gib.`
+
+const groupByFlagDesc = `Group the rows by the values of the given columns,
+showing the aggregates given with --agg for each group.`
+
+const aggFlagDesc = `Aggregate the rows of each group, or all of them
+without --group-by. Available aggregates are count,
+sum(column), avg(column), min(column) and max(column).`
+
+const sortFlagDesc = `Sort the output by the given columns, e.g.
+status,-created,run_number. Columns prefixed with '-'
+are sorted in descending order.`
+
+// tableOptions contains the values of the --columns, --group-by and --agg flags.
+type tableOptions struct {
+	columns      []string
+	groupBy      []string
+	aggregations []string
+}
+
+// addTableFlags adds the --columns, --group-by and --agg flags, which customize the displayed table.
+func addTableFlags(f *pflag.FlagSet, o *tableOptions) {
+	f.StringSliceVar(&o.columns, "columns", []string{}, columnsFlagDesc)
+	f.StringSliceVar(&o.groupBy, "group-by", []string{}, groupByFlagDesc)
+	f.StringSliceVar(&o.aggregations, "agg", []string{}, aggFlagDesc)
+}
+
+// report parses the --columns, --group-by and --agg flags into a report. They cannot be used together with --format, which selects columns too.
+func (o *tableOptions) report(formatFilters []string) (formatter.Report, error) {
+	report, err := formatter.ParseReport(o.columns, o.groupBy, o.aggregations)
+	if err != nil {
+		return report, err
+	}
+	if !report.IsEmpty() && len(formatFilters) > 0 {
+		return report, errors.New("--columns, --group-by and --agg cannot be used together with --format")
+	}
+	return report, nil
+}
+
+// singleKeyDescendingSortFlagDesc completes sortFlagDesc for the commands whose --sort flag
+// used to accept a single column, sorted in descending order.
+const singleKeyDescendingSortFlagDesc = ` A single column without
+prefix is sorted in descending order too, prefix it
+with '+' to sort it in ascending order.`
+
+// sortOptions contains the value of the --sort flag.
+type sortOptions struct {
+	sortColumns []string
+	// singleKeyDescending sorts a single column given without prefix in descending order,
+	// for compatibility with the commands that used to sort by a single column that way.
+	singleKeyDescending bool
+}
+
+// addSortFlag adds the --sort flag, which sorts the displayed table by the given columns by default.
+func addSortFlag(f *pflag.FlagSet, o *sortOptions, defaultColumns []string) {
+	desc := sortFlagDesc
+	if o.singleKeyDescending {
+		desc += singleKeyDescendingSortFlagDesc
+	}
+	f.StringSliceVar(&o.sortColumns, "sort", defaultColumns, desc)
+}
+
+// sortKeys parses the --sort flag into the keys to sort the table by.
+func (o *sortOptions) sortKeys() ([]formatter.SortKey, error) {
+	keys, err := formatter.ParseSortKeys(o.sortColumns)
+	if err != nil {
+		return nil, err
+	}
+	if o.singleKeyDescending && len(keys) == 1 &&
+		!strings.HasPrefix(strings.TrimSpace(o.sortColumns[0]), "+") {
+		keys[0].Descending = true
+	}
+	return keys, nil
+}
@@ -0,0 +1,376 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/pkg/datautils"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/fileutils"
+	"reanahub/reana-client-go/pkg/timeline"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const timelineDesc = `
+Show the timeline of the jobs of a workflow.
+
+The ` + "``timeline``" + ` command displays when each job of the workflow ran as a Gantt
+chart, together with the duration statistics of each step. The jobs on the
+critical path, i.e. the chain of jobs that determined the total duration of the
+workflow, are drawn with '#', the other jobs with '=', and the jobs that are still
+running end with '>'. As the dependencies between jobs are not known, a job is
+considered to wait for the jobs that finished before it started.
+
+The timeline can also be exported in the Chrome trace-event format with
+` + "``--trace``" + `, to be opened in Perfetto (https://ui.perfetto.dev) or chrome://tracing.
+
+Examples:
+
+  $ reana-client timeline -w myanalysis.42
+
+  $ reana-client timeline -w myanalysis.42 --width 100
+
+  $ reana-client timeline -w myanalysis.42 --trace myanalysis.trace.json
+`
+
+// timelineDefaultWidth is the default width of the Gantt chart, in characters.
+const timelineDefaultWidth = 50
+
+type timelineOptions struct {
+	token      string
+	workflow   string
+	width      int
+	trace      string
+	jsonOutput bool
+}
+
+// timelineJob is a job of the timeline, as displayed in JSON format. Durations are in seconds.
+type timelineJob struct {
+	JobID          string  `json:"job_id"`
+	Step           string  `json:"step"`
+	Status         string  `json:"status"`
+	ComputeBackend string  `json:"compute_backend"`
+	StartedAt      string  `json:"started_at"`
+	FinishedAt     *string `json:"finished_at"`
+	Duration       float64 `json:"duration"`
+	CriticalPath   bool    `json:"critical_path"`
+}
+
+// timelineStep contains the duration statistics of a step, as displayed in JSON format.
+// Durations are in seconds.
+type timelineStep struct {
+	Step   string  `json:"step"`
+	Jobs   int     `json:"jobs"`
+	Failed int     `json:"failed"`
+	Total  float64 `json:"total"`
+	Mean   float64 `json:"mean"`
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+}
+
+// timelineOutput is the timeline of a workflow, as displayed in JSON format.
+type timelineOutput struct {
+	Workflow     string         `json:"workflow"`
+	WallTime     float64        `json:"wall_time"`
+	CriticalPath []string       `json:"critical_path"`
+	Jobs         []timelineJob  `json:"jobs"`
+	Steps        []timelineStep `json:"steps"`
+}
+
+// newTimelineCmd creates a command to show the timeline of the jobs of a workflow.
+func newTimelineCmd() *cobra.Command {
+	o := &timelineOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "timeline",
+		Short: "Show the timeline of the jobs of a workflow.",
+		Long:  timelineDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if o.width < 10 {
+				return errors.New("invalid value for '--width': it must be at least 10")
+			}
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(
+		&o.token,
+		"access-token",
+		"t",
+		"",
+		"Access token of the current user.",
+	)
+	f.StringVarP(
+		&o.workflow,
+		"workflow",
+		"w",
+		"",
+		"Name or UUID of the workflow. Overrides value of REANA_WORKON environment variable.",
+	)
+	f.IntVar(
+		&o.width,
+		"width",
+		timelineDefaultWidth,
+		"Width of the Gantt chart, in characters.",
+	)
+	f.StringVar(
+		&o.trace,
+		"trace",
+		"",
+		`Export the timeline to the given file in the Chrome
+trace-event format. Use '-' to print it.`,
+	)
+	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
+
+	return cmd
+}
+
+func (o *timelineOptions) run(cmd *cobra.Command) error {
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	workflowLogs, err := getWorkflowLogs(api, o.token, o.workflow)
+	if err != nil {
+		return err
+	}
+
+	jobs, err := buildTimelineJobs(workflowLogs.JobLogs, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	criticalPath := timeline.CriticalPath(jobs)
+
+	out := cmd.OutOrStdout()
+	if o.trace != "" {
+		return o.writeTrace(jobs, criticalPath, out)
+	}
+	if o.jsonOutput {
+		return displayer.DisplayJsonOutput(
+			buildTimelineOutput(o.workflow, jobs, criticalPath),
+			out,
+		)
+	}
+	if len(jobs) == 0 {
+		displayer.DisplayMessage(
+			fmt.Sprintf("No jobs of workflow %s have started yet.", o.workflow),
+			displayer.Info,
+			false,
+			out,
+		)
+		return nil
+	}
+	displayTimeline(jobs, criticalPath, o.width, out)
+	return nil
+}
+
+// writeTrace writes the timeline in the Chrome trace-event format to the --trace file.
+func (o *timelineOptions) writeTrace(
+	jobs []timeline.Job,
+	criticalPath []int,
+	out io.Writer,
+) error {
+	trace := timeline.BuildTrace(o.workflow, jobs, criticalPath)
+	if o.trace == "-" {
+		return displayer.DisplayJsonOutput(trace, out)
+	}
+
+	file, err := fileutils.CreateFile(o.trace)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if err := displayer.DisplayJsonOutput(trace, file); err != nil {
+		return err
+	}
+	displayer.DisplayMessage(
+		fmt.Sprintf("Timeline of workflow %s exported to %s", o.workflow, o.trace),
+		displayer.Success,
+		false,
+		out,
+	)
+	return nil
+}
+
+// buildTimelineJobs returns the jobs that have started, sorted by start time.
+// The jobs that have not finished yet are considered to end now.
+func buildTimelineJobs(
+	jobLogs map[string]jobLogItem,
+	now time.Time,
+) ([]timeline.Job, error) {
+	var jobs []timeline.Job
+	for _, source := range jobLogSources(jobLogs) {
+		item := jobLogs[source.jobID]
+		if item.StartedAt == nil || *item.StartedAt == "" {
+			continue
+		}
+		start, err := datautils.FromIsoToTimestamp(*item.StartedAt)
+		if err != nil {
+			return nil, err
+		}
+		job := timeline.Job{
+			ID:      source.jobID,
+			Step:    source.step,
+			Status:  item.Status,
+			Backend: item.ComputeBackend,
+			Start:   start,
+			End:     now,
+			Running: true,
+		}
+		if item.FinishedAt != nil && *item.FinishedAt != "" {
+			job.End, err = datautils.FromIsoToTimestamp(*item.FinishedAt)
+			if err != nil {
+				return nil, err
+			}
+			job.Running = false
+		}
+		if job.End.Before(job.Start) {
+			job.End = job.Start
+		}
+		jobs = append(jobs, job)
+	}
+	timeline.Sort(jobs)
+	return jobs, nil
+}
+
+// displayTimeline displays the Gantt chart of the jobs, the duration statistics of each step
+// and the critical path.
+func displayTimeline(jobs []timeline.Job, criticalPath []int, width int, out io.Writer) {
+	critical := make(map[int]bool, len(criticalPath))
+	for _, i := range criticalPath {
+		critical[i] = true
+	}
+	start, end := timeline.Span(jobs)
+
+	var rows [][]string
+	for i, job := range jobs {
+		fill := '='
+		if critical[i] {
+			fill = '#'
+		}
+		rows = append(rows, []string{
+			job.Step,
+			job.ID,
+			job.Status,
+			"+" + formatTimelineDuration(job.Start.Sub(start)),
+			formatTimelineDuration(job.Duration()),
+			"|" + timeline.Bar(job, start, end, width, fill) + "|",
+		})
+	}
+	displayer.DisplayTable(
+		[]string{"step", "job_id", "status", "start", "duration", "timeline"},
+		rows,
+		out,
+	)
+
+	fmt.Fprintln(out)
+	var statsRows [][]string
+	for _, stats := range timeline.ComputeStepStats(jobs) {
+		statsRows = append(statsRows, []string{
+			stats.Step,
+			strconv.Itoa(stats.Jobs),
+			strconv.Itoa(stats.Failed),
+			formatTimelineDuration(stats.Total),
+			formatTimelineDuration(stats.Mean),
+			formatTimelineDuration(stats.Min),
+			formatTimelineDuration(stats.Max),
+		})
+	}
+	displayer.DisplayTable(
+		[]string{"step", "jobs", "failed", "total", "mean", "min", "max"},
+		statsRows,
+		out,
+	)
+
+	fmt.Fprintln(out)
+	var pathSteps []string
+	var pathDuration time.Duration
+	for _, i := range criticalPath {
+		pathSteps = append(pathSteps, jobs[i].Step)
+		pathDuration += jobs[i].Duration()
+	}
+	displayer.DisplayMessage(
+		fmt.Sprintf(
+			"Critical path: %s (%s of jobs in %s of wall time)",
+			strings.Join(pathSteps, " -> "),
+			formatTimelineDuration(pathDuration),
+			formatTimelineDuration(end.Sub(start)),
+		),
+		displayer.Info,
+		false,
+		out,
+	)
+}
+
+// buildTimelineOutput returns the timeline of the workflow, ready to be displayed in JSON format.
+func buildTimelineOutput(
+	workflow string,
+	jobs []timeline.Job,
+	criticalPath []int,
+) timelineOutput {
+	output := timelineOutput{
+		Workflow:     workflow,
+		CriticalPath: []string{},
+		Jobs:         []timelineJob{},
+		Steps:        []timelineStep{},
+	}
+	critical := make(map[int]bool, len(criticalPath))
+	for _, i := range criticalPath {
+		critical[i] = true
+		output.CriticalPath = append(output.CriticalPath, jobs[i].ID)
+	}
+	if len(jobs) > 0 {
+		start, end := timeline.Span(jobs)
+		output.WallTime = end.Sub(start).Seconds()
+	}
+
+	for i, job := range jobs {
+		var finishedAt *string
+		if !job.Running {
+			finished := job.End.Format("2006-01-02T15:04:05")
+			finishedAt = &finished
+		}
+		output.Jobs = append(output.Jobs, timelineJob{
+			JobID:          job.ID,
+			Step:           job.Step,
+			Status:         job.Status,
+			ComputeBackend: job.Backend,
+			StartedAt:      job.Start.Format("2006-01-02T15:04:05"),
+			FinishedAt:     finishedAt,
+			Duration:       job.Duration().Seconds(),
+			CriticalPath:   critical[i],
+		})
+	}
+	for _, stats := range timeline.ComputeStepStats(jobs) {
+		output.Steps = append(output.Steps, timelineStep{
+			Step:   stats.Step,
+			Jobs:   stats.Jobs,
+			Failed: stats.Failed,
+			Total:  stats.Total.Seconds(),
+			Mean:   stats.Mean.Seconds(),
+			Min:    stats.Min.Seconds(),
+			Max:    stats.Max.Seconds(),
+		})
+	}
+	return output
+}
+
+// formatTimelineDuration formats a duration rounded to the second, e.g. 1h2m3s.
+func formatTimelineDuration(duration time.Duration) string {
+	return duration.Round(time.Second).String()
+}
@@ -0,0 +1,132 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTimeline(t *testing.T) {
+	workflowName := "my_workflow"
+	logsResponse := map[string]ServerResponse{
+		fmt.Sprintf(logsPathTemplate, workflowName): {
+			statusCode:   http.StatusOK,
+			responseFile: "logs_timeline.json",
+		},
+	}
+	tracePath := filepath.Join(t.TempDir(), "timeline.json")
+
+	tests := map[string]TestCmdParams{
+		"gantt chart": {
+			serverResponses: logsResponse,
+			args:            []string{"-w", workflowName, "--width", "40"},
+			expected: []string{
+				"STEP", "JOB_ID", "START", "DURATION", "TIMELINE",
+				"|#####                                   |",
+				"|     ##############################     |",
+				"|     ==========                         |",
+				"|                                   #####|",
+				"+35m0s", "30m0s",
+				"JOBS", "FAILED", "TOTAL", "MEAN", "MIN", "MAX",
+				"40m0s", "20m0s",
+				"Critical path: fetch -> fit -> plot (40m0s of jobs in 40m0s of wall time)",
+			},
+			unwanted: []string{"upload"},
+		},
+		"json": {
+			serverResponses: logsResponse,
+			args:            []string{"-w", workflowName, "--json"},
+			expected: []string{
+				"\"wall_time\": 2400",
+				"\"critical_path\": [\n    \"1\",\n    \"2\",\n    \"4\"\n  ]",
+				"\"job_id\": \"3\"", "\"compute_backend\": \"HTCondor\"", "\"duration\": 600",
+				"\"step\": \"fit\"", "\"jobs\": 2", "\"failed\": 1", "\"total\": 2400",
+			},
+		},
+		"trace to stdout": {
+			serverResponses: logsResponse,
+			args:            []string{"-w", workflowName, "--trace", "-"},
+			expected: []string{
+				"\"traceEvents\"", "\"ph\": \"X\"", "\"name\": \"fit\"",
+				"\"dur\": 1800000000", "\"critical_path\": true", "\"tid\": 2",
+			},
+		},
+		"trace to file": {
+			serverResponses: logsResponse,
+			args:            []string{"-w", workflowName, "--trace", tracePath},
+			expected:        []string{"Timeline of workflow my_workflow exported to " + tracePath},
+		},
+		"no started jobs": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(logsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "logs_empty.json",
+				},
+			},
+			args:     []string{"-w", workflowName},
+			expected: []string{"No jobs of workflow my_workflow have started yet."},
+		},
+		"invalid width": {
+			args:      []string{"-w", workflowName, "--width", "5"},
+			expected:  []string{"invalid value for '--width': it must be at least 10"},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "timeline"
+			testCmdRun(t, params)
+		})
+	}
+
+	data, err := os.ReadFile(tracePath)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	var trace map[string]any
+	if err := json.Unmarshal(data, &trace); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if events, ok := trace["traceEvents"].([]any); !ok || len(events) != 5 {
+		t.Errorf("Expected 5 trace events, got %v", trace["traceEvents"])
+	}
+}
+
+func TestBuildTimelineJobs(t *testing.T) {
+	started := "2026-01-01T10:00:00"
+	now := time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC)
+	jobs, err := buildTimelineJobs(map[string]jobLogItem{
+		"1": {JobName: "fit", Status: "running", StartedAt: &started},
+		"2": {JobName: "plot", Status: "created"},
+	}, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(jobs) != 1 {
+		t.Fatalf("Expected 1 job, got %d", len(jobs))
+	}
+	if !jobs[0].Running || jobs[0].Duration() != 30*time.Minute {
+		t.Errorf("Unexpected running job %+v", jobs[0])
+	}
+
+	invalid := "yesterday"
+	_, err = buildTimelineJobs(map[string]jobLogItem{
+		"1": {JobName: "fit", StartedAt: &invalid},
+	}, now)
+	if err == nil {
+		t.Errorf("Expected an error for an invalid start date")
+	}
+}
@@ -0,0 +1,273 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/validator"
+	"reanahub/reana-client-go/pkg/workflows"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const validateDesc = `
+Validate workflow specification file.
+
+The ` + "``validate``" + ` command allows to check syntax and validate the reana.yaml
+workflow specification file. It also checks that the secrets referenced by the
+workflow, either as environment variables or as mounted files, exist on the
+REANA server, and that the workflow engine, the workspace and the Kubernetes
+resources requested by the steps are within the limits of the server.
+Environment variables used in the commands that are neither input parameters,
+secrets nor assigned by the commands themselves are reported as possibly unset.
+
+Examples:
+
+  $ reana-client validate
+
+  $ reana-client validate -f reana.yaml
+`
+
+type validateOptions struct {
+	token string
+	file  string
+}
+
+// newValidateCmd creates a command to validate a workflow specification file.
+func newValidateCmd() *cobra.Command {
+	o := &validateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate workflow specification file.",
+		Long:  validateDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validator.ValidateFile(o.file); err != nil {
+				return fmt.Errorf("invalid value for '--file': %s", err.Error())
+			}
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(
+		&o.token,
+		"access-token",
+		"t",
+		"",
+		"Access token of the current user.",
+	)
+	f.StringVarP(
+		&o.file,
+		"file",
+		"f",
+		"reana.yaml",
+		"REANA specification file describing the workflow to execute.",
+	)
+
+	return cmd
+}
+
+func (o *validateOptions) run(cmd *cobra.Command) error {
+	out := cmd.OutOrStdout()
+
+	displayer.DisplayMessage(
+		fmt.Sprintf("Verifying REANA specification file... %s", o.file),
+		displayer.Info,
+		false,
+		out,
+	)
+	spec, err := workflows.LoadSpecification(o.file)
+	if err != nil {
+		return err
+	}
+	if _, err := workflows.GetSpecificationType(spec); err != nil {
+		return err
+	}
+	displayer.DisplayMessage(
+		"Valid REANA specification file.",
+		displayer.Success,
+		true,
+		out,
+	)
+
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	displayer.DisplayMessage(
+		"Verifying secrets referenced by the workflow...",
+		displayer.Info,
+		false,
+		out,
+	)
+	missing, err := checkSecretReferences(api, o.token, spec, out)
+	if err != nil {
+		return err
+	}
+	if missing == 0 {
+		displayer.DisplayMessage(
+			"All referenced secrets are available.",
+			displayer.Success,
+			true,
+			out,
+		)
+	}
+
+	displayer.DisplayMessage(
+		"Verifying workflow against server limits...",
+		displayer.Info,
+		false,
+		out,
+	)
+	violations, err := checkServerLimits(api, o.token, spec, out)
+	if err != nil {
+		return err
+	}
+	if violations > 0 {
+		return config.ErrEmpty
+	}
+	displayer.DisplayMessage(
+		"Workflow is within the server limits.",
+		displayer.Success,
+		true,
+		out,
+	)
+
+	return nil
+}
+
+// checkSecretReferences compares the secrets referenced in the specification with the secrets of the user,
+// displaying a warning for each referenced secret that does not exist on the server, or for each
+// environment variable that is possibly unset if it is only guessed to be a secret.
+// Returns the number of missing secrets.
+func checkSecretReferences(
+	api *client.API,
+	token string,
+	spec map[string]any,
+	out io.Writer,
+) (int, error) {
+	references := workflows.FindSecretReferences(spec)
+	if len(references) == 0 {
+		return 0, nil
+	}
+
+	secretsParams := operations.NewGetSecretsParams()
+	secretsParams.SetAccessToken(&token)
+	secretsResp, err := api.Operations.GetSecrets(secretsParams)
+	if err != nil {
+		return 0, err
+	}
+	var secretNames []string
+	for _, secret := range secretsResp.Payload {
+		secretNames = append(secretNames, secret.Name)
+	}
+
+	missing := workflows.MissingSecrets(references, secretNames)
+	for _, ref := range missing {
+		if ref.Guessed {
+			displayer.DisplayMessage(
+				fmt.Sprintf(
+					"Environment variable $%s is neither an input parameter nor a secret, it is possibly unset. "+
+						"If it is a secret, you can add it with secrets-add.",
+					ref.Name,
+				),
+				displayer.Warning,
+				true,
+				out,
+			)
+			continue
+		}
+		var usage string
+		if ref.Type == "file" {
+			usage = fmt.Sprintf("as file %s/%s", config.SecretsMountPath, ref.Name)
+		} else {
+			usage = fmt.Sprintf("as environment variable $%s", ref.Name)
+		}
+		displayer.DisplayMessage(
+			fmt.Sprintf(
+				"Secret '%s' is referenced %s but does not exist. You can add it with secrets-add.",
+				ref.Name,
+				usage,
+			),
+			displayer.Warning,
+			true,
+			out,
+		)
+	}
+	log.Debugf(
+		"%d secret references found, %d missing",
+		len(references),
+		len(missing),
+	)
+	return len(missing), nil
+}
+
+// getServerLimits retrieves the limits that workflow specifications must comply with from the server info.
+func getServerLimits(api *client.API, token string) (workflows.ServerLimits, error) {
+	var limits workflows.ServerLimits
+	p, err := getServerInfo(api, token, false)
+	if err != nil {
+		return limits, err
+	}
+
+	if p.KubernetesMaxCPULimit != nil && p.KubernetesMaxCPULimit.Value != nil {
+		limits.MaxCPULimit = *p.KubernetesMaxCPULimit.Value
+	}
+	if p.KubernetesMaxMemoryLimit != nil && p.KubernetesMaxMemoryLimit.Value != nil {
+		limits.MaxMemoryLimit = *p.KubernetesMaxMemoryLimit.Value
+	}
+	if p.MaximumKubernetesJobsTimeout != nil {
+		limits.MaxJobTimeout = p.MaximumKubernetesJobsTimeout.Value
+	}
+	if p.SupportedWorkflowEngines != nil {
+		limits.WorkflowEngines = p.SupportedWorkflowEngines.Value
+	}
+	if p.WorkspacesAvailable != nil {
+		limits.WorkspacesAvailable = p.WorkspacesAvailable.Value
+	}
+	if p.DaskEnabled != nil {
+		daskEnabled := strings.ToLower(p.DaskEnabled.Value) == "true"
+		limits.DaskEnabled = &daskEnabled
+	}
+	return limits, nil
+}
+
+// checkServerLimits compares the specification with the server limits, displaying an error for each violation.
+// Returns the number of violations.
+func checkServerLimits(
+	api *client.API,
+	token string,
+	spec map[string]any,
+	out io.Writer,
+) (int, error) {
+	limits, err := getServerLimits(api, token)
+	if err != nil {
+		return 0, err
+	}
+
+	violations := workflows.CheckServerLimits(spec, limits)
+	if workflows.RequestsDask(spec) {
+		if err := requireServerFeature(api, token, "dask", "Dask"); err != nil {
+			violations = append(violations, err.Error())
+		}
+	}
+	for _, violation := range violations {
+		displayer.DisplayMessage(violation, displayer.Error, true, out)
+	}
+	return len(violations), nil
+}
@@ -0,0 +1,230 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"net/http"
+	"os"
+	"testing"
+)
+
+const validSpecification = `
+version: 0.9.0
+inputs:
+  parameters:
+    data: results/data.root
+workflow:
+  type: serial
+  specification:
+    steps:
+      - environment: 'docker.io/reanahub/reana-env-root6:6.18.04'
+        commands:
+          - root -b -q 'code/gendata.C("${data}")'
+`
+
+const secretsSpecification = `
+version: 0.9.0
+workflow:
+  type: serial
+  specification:
+    steps:
+      - environment: 'docker.io/reanahub/reana-env-root6:6.18.04'
+        voms_proxy: true
+        commands:
+          - echo $secret1 && cat /etc/reana/secrets/secret2
+          - cat /etc/reana/secrets/missing.key
+          - for SAMPLE in $SAMPLES; do echo $SAMPLE; done
+`
+
+const limitsSpecification = `
+version: 0.9.0
+workspace:
+  root_path: /var/cern
+workflow:
+  type: nextflow
+  specification:
+    steps:
+      - name: fit
+        environment: 'docker.io/reanahub/reana-env-root6:6.18.04'
+        kubernetes_cpu_limit: 8
+        kubernetes_memory_limit: 2Gi
+        commands:
+          - root -b -q 'code/fitdata.C'
+      - environment: 'docker.io/reanahub/reana-env-root6:6.18.04'
+        kubernetes_memory_limit: lots
+        commands:
+          - echo done
+`
+
+const daskSpecification = `
+version: 0.9.0
+workflow:
+  type: serial
+  resources:
+    dask:
+      image: 'docker.io/coffeateam/coffea-dask-almalinux8:2024.5.0-py3.11'
+  specification:
+    steps:
+      - environment: 'docker.io/reanahub/reana-env-root6:6.18.04'
+        commands:
+          - python analysis.py
+`
+
+func TestValidate(t *testing.T) {
+	tempDir := t.TempDir()
+	validFile := tempDir + "/reana.yaml"
+	secretsFile := tempDir + "/reana-secrets.yaml"
+	limitsFile := tempDir + "/reana-limits.yaml"
+	daskFile := tempDir + "/reana-dask.yaml"
+	noTypeFile := tempDir + "/reana-no-type.yaml"
+	invalidFile := tempDir + "/reana-invalid.yaml"
+	files := map[string]string{
+		validFile:   validSpecification,
+		secretsFile: secretsSpecification,
+		limitsFile:  limitsSpecification,
+		daskFile:    daskSpecification,
+		noTypeFile:  "version: 0.9.0\nworkflow:\n  specification: {}\n",
+		invalidFile: "workflow: [",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+			t.Fatalf("Error while creating test file: %s", err.Error())
+		}
+	}
+
+	tests := map[string]TestCmdParams{
+		"valid without secrets": {
+			args: []string{"-f", validFile},
+			expected: []string{
+				"Valid REANA specification file.",
+				"All referenced secrets are available.",
+				"Workflow is within the server limits.",
+			},
+		},
+		"missing secrets": {
+			serverResponses: map[string]ServerResponse{
+				secretsListServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "secrets_list.json",
+				},
+			},
+			args: []string{"-f", secretsFile},
+			expected: []string{
+				"Valid REANA specification file.",
+				"Secret 'VOMSPROXY_FILE' is referenced as environment variable $VOMSPROXY_FILE",
+				"Secret 'VOMSPROXY_PASS' is referenced as environment variable $VOMSPROXY_PASS",
+				"Secret 'VONAME' is referenced as environment variable $VONAME",
+				"Secret 'missing.key' is referenced as file /etc/reana/secrets/missing.key",
+				"Environment variable $SAMPLES is neither an input parameter nor a secret, it is possibly unset.",
+			},
+			unwanted: []string{
+				"'secret2'",
+				"$SAMPLE ",
+				"All referenced secrets are available.",
+			},
+		},
+		"secrets server error": {
+			serverResponses: map[string]ServerResponse{
+				secretsListServerPath: {
+					statusCode:   http.StatusInternalServerError,
+					responseFile: "common_internal_server_error.json",
+				},
+			},
+			args:      []string{"-f", secretsFile},
+			expected:  []string{"Error while querying"},
+			wantError: true,
+		},
+		"exceeds server limits": {
+			args: []string{"-f", limitsFile},
+			expected: []string{
+				"workflow type 'nextflow' is not supported by the server. Supported engines are 'cwl', 'serial', 'snakemake', 'yadage'",
+				"step 'fit': kubernetes_cpu_limit '8' exceeds the maximum allowed by the server '4'",
+				"workflow.specification.steps[1]: invalid kubernetes_memory_limit, 'lots' is not a valid memory quantity",
+			},
+			unwanted:  []string{"workspace", "2Gi", "Workflow is within the server limits."},
+			wantError: true,
+		},
+		"dask not enabled": {
+			args: []string{"-f", daskFile},
+			expected: []string{
+				"workflow requests a Dask cluster but Dask is not enabled on the server",
+			},
+			wantError: true,
+		},
+		"dask enabled": {
+			serverResponses: map[string]ServerResponse{
+				infoServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "info_dask.json",
+				},
+			},
+			args:     []string{"-f", daskFile},
+			expected: []string{"Workflow is within the server limits."},
+		},
+		"dask not supported by old server": {
+			serverResponses: map[string]ServerResponse{
+				infoServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "info_small.json",
+				},
+				pingServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "ping.json",
+				},
+			},
+			args: []string{"-f", daskFile},
+			expected: []string{
+				"Dask is not supported by server 0.9, it requires REANA 0.95 or newer",
+			},
+			wantError: true,
+		},
+		"info server error": {
+			serverResponses: map[string]ServerResponse{
+				infoServerPath: {
+					statusCode:   http.StatusInternalServerError,
+					responseFile: "common_internal_server_error.json",
+				},
+			},
+			args:      []string{"-f", validFile},
+			expected:  []string{"Error while querying"},
+			wantError: true,
+		},
+		"missing workflow type": {
+			args:      []string{"-f", noTypeFile},
+			expected:  []string{"specification does not declare a 'workflow.type'"},
+			wantError: true,
+		},
+		"invalid yaml": {
+			args:      []string{"-f", invalidFile},
+			expected:  []string{"is not valid YAML"},
+			wantError: true,
+		},
+		"unexisting file": {
+			args:      []string{"-f", tempDir + "/unexisting.yaml"},
+			expected:  []string{"invalid value for '--file'"},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "validate"
+			if params.serverResponses == nil {
+				params.serverResponses = map[string]ServerResponse{}
+			}
+			if _, ok := params.serverResponses[infoServerPath]; !ok {
+				params.serverResponses[infoServerPath] = ServerResponse{
+					statusCode:   http.StatusOK,
+					responseFile: "info_big.json",
+				}
+			}
+			testCmdRun(t, params)
+		})
+	}
+}
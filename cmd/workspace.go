@@ -0,0 +1,205 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"io"
+	"net/http"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/datautils"
+	"reanahub/reana-client-go/pkg/glob"
+	"reanahub/reana-client-go/pkg/paginator"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// workspaceFile is a file of a workspace, as returned by the server.
+type workspaceFile = operations.GetFilesOKBodyItemsItems0
+
+// listWorkspaceFiles returns all the files of the workspace whose names match fileName and the search
+// filter, both optional, retrieving as many pages as needed.
+func listWorkspaceFiles(
+	api *client.API,
+	token, workflow, fileName, search string,
+) ([]*workspaceFile, error) {
+	options := paginator.Options{Size: config.WorkspaceFilesPageSize, AllPages: true}
+	return newWorkspaceFilesPaginator(api, token, workflow, fileName, search, options).All()
+}
+
+// newWorkspaceFilesPaginator returns a paginator retrieving the files of the workspace whose names
+// match fileName and the search filter, both optional.
+func newWorkspaceFilesPaginator(
+	api *client.API,
+	token, workflow, fileName, search string,
+	options paginator.Options,
+) *paginator.Paginator[*workspaceFile] {
+	return paginator.New(options, func(page, size int64) ([]*workspaceFile, int64, error) {
+		lsParams := operations.NewGetFilesParams()
+		lsParams.SetAccessToken(&token)
+		lsParams.SetWorkflowIDOrName(workflow)
+		if fileName != "" {
+			lsParams.SetFileName(&fileName)
+		}
+		if search != "" {
+			lsParams.SetSearch(&search)
+		}
+		lsParams.SetPage(&page)
+		if size > 0 {
+			lsParams.SetSize(&size)
+		}
+
+		lsResp, err := api.Operations.GetFiles(lsParams)
+		if err != nil {
+			return nil, 0, err
+		}
+		return lsResp.Payload.Items, lsResp.Payload.Total, nil
+	})
+}
+
+// resolveWorkspacePatterns returns, for each pattern, the names of the workspace files it selects,
+// escaped so that the server does not interpret them as patterns again. Plain paths are returned
+// as they are, so that the server can resolve them, e.g. as directories. Patterns that select no file
+// are resolved to no names. See the glob package for the syntax of patterns and how they select files.
+func resolveWorkspacePatterns(
+	api *client.API,
+	token, workflow string,
+	patterns []string,
+) ([][]string, error) {
+	var files []*workspaceFile
+	resolved := make([][]string, len(patterns))
+	for i, pattern := range patterns {
+		if !glob.HasMeta(pattern) {
+			resolved[i] = []string{pattern}
+			continue
+		}
+		p, err := glob.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if files == nil {
+			files, err = listWorkspaceFiles(api, token, workflow, "", "")
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, file := range files {
+			if p.Select(file.Name) && !datautils.HasAnyPrefix(file.Name, config.FilesBlacklist) {
+				resolved[i] = append(resolved[i], escapeServerPattern(file.Name))
+			}
+		}
+	}
+	return resolved, nil
+}
+
+// resolveExtendedWorkspacePatterns is like resolveWorkspacePatterns, but only resolves the patterns
+// that the server does not support, see glob.HasExtendedMeta. The other patterns are returned as they are,
+// so that the server resolves each of them in a single request.
+func resolveExtendedWorkspacePatterns(
+	api *client.API,
+	token, workflow string,
+	patterns []string,
+) ([][]string, error) {
+	var extended []string
+	for _, pattern := range patterns {
+		if glob.HasExtendedMeta(pattern) {
+			extended = append(extended, pattern)
+		}
+	}
+	resolvedExtended, err := resolveWorkspacePatterns(api, token, workflow, extended)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make([][]string, len(patterns))
+	for i, pattern := range patterns {
+		if glob.HasExtendedMeta(pattern) {
+			resolved[i], resolvedExtended = resolvedExtended[0], resolvedExtended[1:]
+		} else {
+			resolved[i] = []string{pattern}
+		}
+	}
+	return resolved, nil
+}
+
+// escapeServerPattern escapes the special characters of a file name, so that the server,
+// which expands the names it receives as shell patterns, only selects this file.
+func escapeServerPattern(name string) string {
+	var escaped strings.Builder
+	for _, c := range name {
+		if strings.ContainsRune("*?[", c) {
+			escaped.WriteString("[" + string(c) + "]")
+		} else {
+			escaped.WriteRune(c)
+		}
+	}
+	return escaped.String()
+}
+
+// openWorkspaceFile returns a reader of the content of a file of the workspace, as it is downloaded.
+// Reading it returns the error of the download, if any. Closing it stops the download.
+func openWorkspaceFile(api *client.API, token, workflow, name string) io.ReadCloser {
+	reader, writer := io.Pipe()
+	go func() {
+		// A nil error closes the pipe with io.EOF
+		writer.CloseWithError(downloadWorkspaceFileTo(api, token, workflow, name, writer))
+	}()
+	return reader
+}
+
+// downloadWorkspaceFileTo writes the content of a file of the workspace to w, as it is downloaded.
+func downloadWorkspaceFileTo(api *client.API, token, workflow, name string, w io.Writer) error {
+	downloadParams := operations.NewDownloadFileParams()
+	downloadParams.SetAccessToken(&token)
+	downloadParams.SetWorkflowIDOrName(workflow)
+	downloadParams.SetFileName(escapeServerPattern(name))
+
+	_, err := api.Operations.DownloadFile(downloadParams, w, withRawResponse())
+	return err
+}
+
+// withRawResponse makes the body of successful responses be copied as is, whatever its content type,
+// e.g. so that downloaded JSON files are not decoded.
+func withRawResponse() operations.ClientOption {
+	return func(op *runtime.ClientOperation) {
+		reader := op.Reader
+		op.Reader = runtime.ClientResponseReaderFunc(
+			func(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+				if response.Code() == http.StatusOK {
+					consumer = runtime.ByteStreamConsumer()
+				}
+				return reader.ReadResponse(response, consumer)
+			},
+		)
+	}
+}
+
+// withRequestBody sends body as the payload of the request instead of the one given in its parameters,
+// so that it is streamed rather than held in memory.
+func withRequestBody(body io.Reader) operations.ClientOption {
+	return func(op *runtime.ClientOperation) {
+		op.Params = streamedBodyParams{ClientRequestWriter: op.Params, body: body}
+	}
+}
+
+// streamedBodyParams writes the parameters of a request, replacing its body.
+type streamedBodyParams struct {
+	runtime.ClientRequestWriter
+	body io.Reader
+}
+
+func (p streamedBodyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+	if err := p.ClientRequestWriter.WriteToRequest(r, reg); err != nil {
+		return err
+	}
+	return r.SetBodyParam(p.body)
+}
@@ -0,0 +1,26 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import "testing"
+
+func TestEscapeServerPattern(t *testing.T) {
+	tests := map[string]string{
+		"data/file.txt":    "data/file.txt",
+		"data/*.txt":       "data/[*].txt",
+		"run?[1].log":      "run[?][[]1].log",
+		"plots/{a,b}.png":  "plots/{a,b}.png",
+		"results/data.csv": "results/data.csv",
+	}
+	for name, want := range tests {
+		if got := escapeServerPattern(name); got != want {
+			t.Errorf("expected %s to be escaped as %s, got %s", name, want, got)
+		}
+	}
+}
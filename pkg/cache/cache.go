@@ -0,0 +1,112 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+// Package cache stores small pieces of data on disk for a limited time, to avoid repeating requests to the server.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// entry is the content of a cache file.
+type entry struct {
+	StoredAt time.Time       `json:"stored_at"`
+	Value    json.RawMessage `json:"value"`
+}
+
+// Dir returns the directory where the client caches data, inside the user cache directory.
+func Dir() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cacheDir, "reana-client-go"), nil
+}
+
+// Load reads the value stored under key into value, if it was stored less than ttl ago.
+// Returns false if the value is missing, expired or cannot be read.
+func Load(key string, ttl time.Duration, value any) bool {
+	path, err := entryPath(key)
+	if err != nil {
+		return false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return false
+	}
+	if time.Since(e.StoredAt) > ttl {
+		return false
+	}
+	return json.Unmarshal(e.Value, value) == nil
+}
+
+// Store saves the value under key, replacing any previous value.
+func Store(key string, value any) error {
+	path, err := entryPath(key)
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(entry{StoredAt: time.Now().UTC(), Value: raw})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that concurrent readers never see a partial entry.
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Remove deletes the value stored under key, if any.
+func Remove(key string) error {
+	path, err := entryPath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// entryPath returns the path of the file storing the given key.
+// Keys are hashed, so that they can safely include server URLs or access tokens.
+func entryPath(key string) (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(dir, hex.EncodeToString(sum[:16])+".json"), nil
+}
@@ -0,0 +1,73 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cache
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStoreAndLoad(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	type value struct {
+		Version string `json:"version"`
+	}
+	var got value
+	if Load("info:https://localhost", time.Hour, &got) {
+		t.Fatalf("Expected missing value, got %v", got)
+	}
+
+	if err := Store("info:https://localhost", value{Version: "0.95.0"}); err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+	if !Load("info:https://localhost", time.Hour, &got) || got.Version != "0.95.0" {
+		t.Errorf("Expected cached value, got %v", got)
+	}
+	if Load("info:https://other", time.Hour, &got) {
+		t.Errorf("Expected other keys not to be cached")
+	}
+	if Load("info:https://localhost", 0, &got) {
+		t.Errorf("Expected expired value not to be loaded")
+	}
+
+	if err := Remove("info:https://localhost"); err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+	if Load("info:https://localhost", time.Hour, &got) {
+		t.Errorf("Expected removed value not to be loaded")
+	}
+	if err := Remove("info:https://localhost"); err != nil {
+		t.Errorf("Expected removing a missing value to succeed, got %s", err.Error())
+	}
+}
+
+func TestKeysAreHashed(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	if err := Store("you:secret-token", "value"); err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+	dir, err := Dir()
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+	if len(files) != 1 {
+		t.Fatalf("Expected one cache file, got %d", len(files))
+	}
+	if strings.Contains(files[0].Name(), "secret-token") {
+		t.Errorf("Expected key to be hashed, got file %s", files[0].Name())
+	}
+}
@@ -0,0 +1,235 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+// Package cleanup evaluates workspace cleanup policies against the workflows of the user.
+package cleanup
+
+import (
+	"fmt"
+	"os"
+	"reanahub/reana-client-go/pkg/datautils"
+	"reanahub/reana-client-go/pkg/validator"
+	"sort"
+	"strings"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// DeleteAction deletes the workflow together with its workspace.
+	DeleteAction = "delete"
+	// PruneAction deletes the intermediate files of the workspace.
+	PruneAction = "prune"
+)
+
+// cleanableStatuses statuses of the workflows that can be cleaned up.
+// Workflows that are pending, queued or running are never selected.
+var cleanableStatuses = []string{"created", "failed", "finished", "stopped"}
+
+// Rule describes which workflows should be cleaned up and how.
+// All the criteria given in a rule must be satisfied for a workflow to be selected.
+type Rule struct {
+	Name           string   `yaml:"name"`
+	Action         string   `yaml:"action"`          // delete or prune
+	NamePrefix     string   `yaml:"name_prefix"`     // select workflows whose name starts with the prefix
+	Status         []string `yaml:"status"`          // select workflows with any of the statuses
+	OlderThan      string   `yaml:"older_than"`      // select workflows created before the duration, e.g. 14d
+	SizeOver       string   `yaml:"size_over"`       // select workspaces bigger than the size, e.g. 50GiB
+	KeepLast       int      `yaml:"keep_last"`       // keep the given number of most recent runs of each workflow name
+	IncludeInputs  bool     `yaml:"include_inputs"`  // prune also the input files
+	IncludeOutputs bool     `yaml:"include_outputs"` // prune also the output files
+
+	olderThan time.Duration
+	sizeOver  int64
+}
+
+// Policy is an ordered list of cleanup rules.
+type Policy struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// Workflow holds the information about a workflow run needed to evaluate a policy.
+type Workflow struct {
+	Name     string // name of the workflow, without run number
+	FullName string // name and run number, used to refer to the workflow
+	Status   string
+	Created  time.Time
+	Size     int64 // workspace size in bytes
+}
+
+// PlannedAction is the action that a policy decided to take on a workflow.
+type PlannedAction struct {
+	Workflow       Workflow
+	Rule           Rule
+	ReclaimedBytes int64 // for prune actions this is an upper bound, as inputs and outputs may be kept
+}
+
+// LoadPolicy reads and validates a cleanup policy from a YAML file.
+func LoadPolicy(path string) (Policy, error) {
+	var policy Policy
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return policy, fmt.Errorf(
+			"policy file %s could not be read: %s",
+			path, err.Error(),
+		)
+	}
+	if err := yaml.Unmarshal(data, &policy); err != nil {
+		return policy, fmt.Errorf(
+			"policy file %s is not valid YAML: %s",
+			path, err.Error(),
+		)
+	}
+	if err := policy.Validate(); err != nil {
+		return policy, err
+	}
+	return policy, nil
+}
+
+// Validate verifies that every rule has a valid action and criteria, and parses the durations and sizes.
+func (p *Policy) Validate() error {
+	if len(p.Rules) == 0 {
+		return fmt.Errorf("policy does not contain any rules")
+	}
+	for i := range p.Rules {
+		rule := &p.Rules[i]
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("rule-%d", i+1)
+		}
+		if err := validator.ValidateChoice(
+			rule.Action,
+			[]string{DeleteAction, PruneAction},
+			rule.Name+" action",
+		); err != nil {
+			return err
+		}
+		for _, status := range rule.Status {
+			if err := validator.ValidateChoice(
+				status,
+				cleanableStatuses,
+				rule.Name+" status",
+			); err != nil {
+				return err
+			}
+		}
+		if rule.KeepLast < 0 {
+			return fmt.Errorf("%s: keep_last must not be negative", rule.Name)
+		}
+		if rule.OlderThan != "" {
+			olderThan, err := datautils.ParseDuration(rule.OlderThan)
+			if err != nil {
+				return fmt.Errorf("%s: %s", rule.Name, err.Error())
+			}
+			rule.olderThan = olderThan
+		}
+		if rule.SizeOver != "" {
+			sizeOver, err := datautils.ParseByteSize(rule.SizeOver)
+			if err != nil {
+				return fmt.Errorf("%s: %s", rule.Name, err.Error())
+			}
+			rule.sizeOver = sizeOver
+		}
+		if rule.NamePrefix == "" && len(rule.Status) == 0 && rule.OlderThan == "" &&
+			rule.SizeOver == "" && rule.KeepLast == 0 {
+			return fmt.Errorf(
+				"%s: at least one of name_prefix, status, older_than, size_over or keep_last is required",
+				rule.Name,
+			)
+		}
+	}
+	return nil
+}
+
+// Plan evaluates the rules in order and returns the actions to be taken, sorted by workflow name.
+// A workflow gets at most one action: the first matching rule wins, except that a delete action
+// from a later rule replaces a prune action. Workflows in progress are never selected.
+func (p Policy) Plan(workflows []Workflow, now time.Time) []PlannedAction {
+	planned := make(map[string]PlannedAction)
+	for _, rule := range p.Rules {
+		for _, workflow := range rule.selectWorkflows(workflows, now) {
+			current, exists := planned[workflow.FullName]
+			if exists && (current.Rule.Action == DeleteAction || rule.Action == PruneAction) {
+				continue
+			}
+			reclaimed := workflow.Size
+			if reclaimed < 0 {
+				reclaimed = 0
+			}
+			planned[workflow.FullName] = PlannedAction{
+				Workflow:       workflow,
+				Rule:           rule,
+				ReclaimedBytes: reclaimed,
+			}
+		}
+	}
+
+	actions := make([]PlannedAction, 0, len(planned))
+	for _, action := range planned {
+		actions = append(actions, action)
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		if actions[i].Workflow.Name != actions[j].Workflow.Name {
+			return actions[i].Workflow.Name < actions[j].Workflow.Name
+		}
+		return actions[i].Workflow.Created.Before(actions[j].Workflow.Created)
+	})
+	return actions
+}
+
+// ReclaimedBytes returns the total number of bytes reclaimed by the given actions.
+func ReclaimedBytes(actions []PlannedAction) int64 {
+	var total int64
+	for _, action := range actions {
+		total += action.ReclaimedBytes
+	}
+	return total
+}
+
+// selectWorkflows returns the workflows matching all the criteria of the rule.
+func (r Rule) selectWorkflows(workflows []Workflow, now time.Time) []Workflow {
+	var matched []Workflow
+	for _, workflow := range workflows {
+		if !slices.Contains(cleanableStatuses, workflow.Status) {
+			continue
+		}
+		if !strings.HasPrefix(workflow.Name, r.NamePrefix) {
+			continue
+		}
+		if len(r.Status) > 0 && !slices.Contains(r.Status, workflow.Status) {
+			continue
+		}
+		if r.olderThan > 0 && !workflow.Created.Before(now.Add(-r.olderThan)) {
+			continue
+		}
+		if r.SizeOver != "" && workflow.Size <= r.sizeOver {
+			continue
+		}
+		matched = append(matched, workflow)
+	}
+
+	if r.KeepLast == 0 {
+		return matched
+	}
+
+	byName := make(map[string][]Workflow)
+	for _, workflow := range matched {
+		byName[workflow.Name] = append(byName[workflow.Name], workflow)
+	}
+	var selected []Workflow
+	for _, runs := range byName {
+		sort.Slice(runs, func(i, j int) bool {
+			return runs[i].Created.After(runs[j].Created)
+		})
+		if len(runs) > r.KeepLast {
+			selected = append(selected, runs[r.KeepLast:]...)
+		}
+	}
+	return selected
+}
@@ -0,0 +1,156 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cleanup
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadPolicy(t *testing.T) {
+	tempDir := t.TempDir()
+	tests := map[string]struct {
+		content  string
+		errorMsg string
+	}{
+		"valid": {
+			content: "rules:\n  - action: delete\n    keep_last: 5\n",
+		},
+		"invalid yaml": {
+			content:  "rules: [",
+			errorMsg: "is not valid YAML",
+		},
+		"no rules": {
+			content:  "rules: []\n",
+			errorMsg: "policy does not contain any rules",
+		},
+		"invalid action": {
+			content:  "rules:\n  - name: r\n    action: archive\n    keep_last: 1\n",
+			errorMsg: "invalid value for 'r action'",
+		},
+		"invalid status": {
+			content:  "rules:\n  - action: delete\n    status: [running]\n",
+			errorMsg: "invalid value for 'rule-1 status'",
+		},
+		"invalid duration": {
+			content:  "rules:\n  - action: delete\n    older_than: soon\n",
+			errorMsg: "rule-1: invalid duration 'soon'",
+		},
+		"invalid size": {
+			content:  "rules:\n  - action: prune\n    size_over: big\n",
+			errorMsg: "rule-1: invalid size 'big'",
+		},
+		"negative keep last": {
+			content:  "rules:\n  - action: delete\n    keep_last: -1\n",
+			errorMsg: "keep_last must not be negative",
+		},
+		"no criteria": {
+			content:  "rules:\n  - action: delete\n",
+			errorMsg: "at least one of name_prefix, status",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := tempDir + "/" + strings.ReplaceAll(name, " ", "_") + ".yaml"
+			if err := os.WriteFile(path, []byte(test.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := LoadPolicy(path)
+			if test.errorMsg == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %s", err.Error())
+				}
+			} else if err == nil || !strings.Contains(err.Error(), test.errorMsg) {
+				t.Errorf("Expected error containing '%s', got %v", test.errorMsg, err)
+			}
+		})
+	}
+}
+
+func TestPlan(t *testing.T) {
+	now := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	runs := []Workflow{
+		{Name: "a", FullName: "a.1", Status: "finished", Created: now.Add(-30 * day), Size: 100},
+		{Name: "a", FullName: "a.2", Status: "failed", Created: now.Add(-20 * day), Size: 200},
+		{Name: "a", FullName: "a.3", Status: "finished", Created: now.Add(-10 * day), Size: 300},
+		{Name: "b", FullName: "b.1", Status: "failed", Created: now.Add(-1 * day), Size: 5000},
+		{Name: "b", FullName: "b.2", Status: "running", Created: now.Add(-40 * day), Size: 5000},
+		{Name: "c", FullName: "c.1", Status: "stopped", Created: now.Add(-40 * day), Size: -1},
+	}
+
+	tests := map[string]struct {
+		rules     []Rule
+		want      map[string]string // workflow -> action
+		reclaimed int64
+	}{
+		"keep last": {
+			rules:     []Rule{{Action: DeleteAction, KeepLast: 1}},
+			want:      map[string]string{"a.1": DeleteAction, "a.2": DeleteAction},
+			reclaimed: 300,
+		},
+		"status and age": {
+			rules:     []Rule{{Action: DeleteAction, Status: []string{"failed", "stopped"}, OlderThan: "14d"}},
+			want:      map[string]string{"a.2": DeleteAction, "c.1": DeleteAction},
+			reclaimed: 200,
+		},
+		"size": {
+			rules:     []Rule{{Action: PruneAction, SizeOver: "1KB"}},
+			want:      map[string]string{"b.1": PruneAction},
+			reclaimed: 5000,
+		},
+		"name prefix": {
+			rules:     []Rule{{Action: PruneAction, NamePrefix: "c"}},
+			want:      map[string]string{"c.1": PruneAction},
+			reclaimed: 0,
+		},
+		"delete replaces prune": {
+			rules: []Rule{
+				{Action: PruneAction, SizeOver: "150B"},
+				{Action: DeleteAction, NamePrefix: "a", KeepLast: 2},
+				{Action: PruneAction, NamePrefix: "a"},
+			},
+			want: map[string]string{
+				"a.1": DeleteAction,
+				"a.2": PruneAction,
+				"a.3": PruneAction,
+				"b.1": PruneAction,
+			},
+			reclaimed: 5600,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			policy := Policy{Rules: test.rules}
+			if err := policy.Validate(); err != nil {
+				t.Fatalf("Unexpected error: %s", err.Error())
+			}
+			actions := policy.Plan(runs, now)
+			got := make(map[string]string)
+			for _, action := range actions {
+				got[action.Workflow.FullName] = action.Rule.Action
+			}
+			if len(got) != len(test.want) {
+				t.Fatalf("Expected %v, got %v", test.want, got)
+			}
+			for workflow, action := range test.want {
+				if got[workflow] != action {
+					t.Errorf("Expected %s for %s, got %s", action, workflow, got[workflow])
+				}
+			}
+			if reclaimed := ReclaimedBytes(actions); reclaimed != test.reclaimed {
+				t.Errorf("Expected %d reclaimed bytes, got %d", test.reclaimed, reclaimed)
+			}
+		})
+	}
+}
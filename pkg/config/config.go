@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2024, 2025 CERN.
+Copyright (C) 2022, 2024, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -9,13 +9,38 @@ under the terms of the MIT License; see LICENSE file for more details.
 // Package config gives constants and small functions that specify the REANA client configuration.
 package config
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 // FilesBlacklist list of files to be ignored.
 var FilesBlacklist = []string{".git/", "/.git/"}
 
-// InteractiveSessionTypes list of supported types of interactive sessions.
-var InteractiveSessionTypes = []string{"jupyter"}
+// WorkspaceFilesPageSize number of files retrieved per request when listing all the files of a workspace.
+var WorkspaceFilesPageSize int64 = 1000
+
+// WorkspaceCopyBufferSize number of bytes buffered when streaming a file from a workspace to another.
+var WorkspaceCopyBufferSize = 1 << 20
+
+// DiffMaxTextSize maximum size in bytes of the text files whose changed lines are shown by diff,
+// larger files being only compared by their size and checksum.
+var DiffMaxTextSize int64 = 10 << 20
+
+// WorkflowsPageSize number of workflows retrieved per request when listing all the workflows.
+var WorkflowsPageSize int64 = 100
+
+// JobLogsPageSize number of job logs retrieved per request when retrieving all the pages of the logs.
+var JobLogsPageSize int64 = 50
+
+// InteractiveSessionReadyStatus status of an interactive session that is ready to be used.
+var InteractiveSessionReadyStatus = "running"
+
+// InteractiveSessionFailedStatuses statuses of an interactive session that will not become ready.
+var InteractiveSessionFailedStatuses = []string{"failed", "stopped", "deleted"}
+
+// InteractiveSessionActiveStatuses statuses of a workflow whose interactive session is never considered idle.
+var InteractiveSessionActiveStatuses = []string{"queued", "pending", "running"}
 
 // ReanaComputeBackends maps the backends' command line references to their real names.
 var ReanaComputeBackends = map[string]string{
@@ -68,6 +93,18 @@ var LogsSingleFilters = []string{"compute_backend", "docker_img", "status"}
 // LogsMultiFilters available filters with multiple values in logs command.
 var LogsMultiFilters = []string{"step"}
 
+// BulkSelectorFlags flags used to select several workflows at once in the delete, stop and share-add commands.
+var BulkSelectorFlags = []string{"filter", "older-than", "name-prefix"}
+
+// BulkConcurrency maximum number of workflows processed at the same time by bulk operations.
+var BulkConcurrency = 5
+
+// ReportTypes available reports in report command.
+var ReportTypes = []string{"usage"}
+
+// ReportGroupByColumns columns by which the workflow runs can be grouped in report command.
+var ReportGroupByColumns = []string{"name", "status", "month"}
+
 // QuotaReports available reports in quota-show command.
 var QuotaReports = []string{"limit", "usage"}
 
@@ -83,6 +120,47 @@ var AvailableOperationalOptions = map[string]map[string]string{
 	"report":         {"snakemake": "report"},
 }
 
+// SecretsMountPath directory where file secrets are mounted inside the job containers.
+var SecretsMountPath = "/etc/reana/secrets"
+
+// SecretsRequiredByOption maps the specification options that rely on user secrets
+// to the names of the environment secrets they need.
+var SecretsRequiredByOption = map[string][]string{
+	"kerberos":   {"CERN_USER", "CERN_KEYTAB"},
+	"voms_proxy": {"VOMSPROXY_FILE", "VOMSPROXY_PASS", "VONAME"},
+	"rucio":      {"RUCIO_USERNAME", "VONAME"},
+}
+
+// CommonEnvironmentVariables environment variables usually present in job containers,
+// which are not considered references to user secrets.
+var CommonEnvironmentVariables = []string{
+	"HOME",
+	"HOSTNAME",
+	"LANG",
+	"LD_LIBRARY_PATH",
+	"PATH",
+	"PWD",
+	"PYTHONPATH",
+	"REANA_WORKSPACE",
+	"ROOTSYS",
+	"SHELL",
+	"TMPDIR",
+	"USER",
+}
+
+// ServerInfoCacheTTL time during which the server information and version are cached.
+var ServerInfoCacheTTL = time.Hour
+
+// CompletionCacheTTL time during which the workflows, files and secrets used for shell completion are cached.
+var CompletionCacheTTL = 30 * time.Second
+
+// ServerFeatureVersions minimum REANA server version supporting each feature.
+var ServerFeatureVersions = map[string]string{
+	"sharing":   "0.95.0",
+	"live-logs": "0.95.0",
+	"dask":      "0.95.0",
+}
+
 // CheckInterval interval between workflow status check, in seconds.
 var CheckInterval = 5
 
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022 CERN.
+Copyright (C) 2022, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -11,6 +11,9 @@ package datautils
 
 import (
 	"errors"
+	"fmt"
+	"math"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -35,6 +38,192 @@ func FromIsoToTimestamp(date string) (time.Time, error) {
 	return timestamp, nil
 }
 
+// dateLayouts layouts of the dates accepted by ParseDate.
+var dateLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04:05.999999",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+	time.RFC3339,
+}
+
+// ParseDate parses a date such as "2026-01-01" or "2026-01-01T10:00:00", with or without time.
+func ParseDate(str string) (time.Time, error) {
+	str = strings.TrimSpace(str)
+	for _, layout := range dateLayouts {
+		if date, err := time.Parse(layout, str); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date '%s'", str)
+}
+
+// ParseDuration parses a duration such as "30d", "2w" or "12h".
+// Besides the units supported by time.ParseDuration, it accepts days (d) and weeks (w) as a single unit.
+func ParseDuration(str string) (time.Duration, error) {
+	str = strings.TrimSpace(str)
+	unitDurations := map[string]time.Duration{
+		"d": 24 * time.Hour,
+		"w": 7 * 24 * time.Hour,
+	}
+	for unit, unitDuration := range unitDurations {
+		if value, found := strings.CutSuffix(str, unit); found {
+			amount, err := strconv.ParseFloat(value, 64)
+			if err != nil || amount < 0 {
+				return 0, fmt.Errorf("invalid duration '%s'", str)
+			}
+			return time.Duration(amount * float64(unitDuration)), nil
+		}
+	}
+
+	duration, err := time.ParseDuration(str)
+	if err != nil || duration < 0 {
+		return 0, fmt.Errorf("invalid duration '%s'", str)
+	}
+	return duration, nil
+}
+
+// Systems of units used to format byte sizes.
+const (
+	// IECUnits are powers of 1024, e.g. KiB, MiB or GiB.
+	IECUnits = "iec"
+	// SIUnits are powers of 1000, e.g. kB, MB or GB.
+	SIUnits = "si"
+)
+
+// ByteSizeUnits systems of units supported when formatting byte sizes.
+var ByteSizeUnits = []string{IECUnits, SIUnits}
+
+// byteUnits maps the supported byte size units to their multipliers.
+var byteUnits = map[string]float64{
+	"b":     1,
+	"byte":  1,
+	"bytes": 1,
+	"kb":    1e3,
+	"mb":    1e6,
+	"gb":    1e9,
+	"tb":    1e12,
+	"pb":    1e15,
+	"kib":   1 << 10,
+	"mib":   1 << 20,
+	"gib":   1 << 30,
+	"tib":   1 << 40,
+	"pib":   1 << 50,
+}
+
+// formatUnits units used when formatting byte sizes in each system, from the smallest to the biggest.
+var formatUnits = map[string][]string{
+	IECUnits: {"Bytes", "KiB", "MiB", "GiB", "TiB", "PiB"},
+	SIUnits:  {"Bytes", "kB", "MB", "GB", "TB", "PB"},
+}
+
+// unitBases ratio between two consecutive units of each system.
+var unitBases = map[string]float64{IECUnits: 1024, SIUnits: 1000}
+
+// ParseByteSize parses a byte size such as "512", "512 Bytes", "50GiB" or "1.5 GB" and returns the number of bytes.
+// Both SI (kB, MB, GB...) and IEC (KiB, MiB, GiB...) units are supported; a number without unit is in bytes.
+func ParseByteSize(str string) (int64, error) {
+	str = strings.TrimSpace(str)
+	numberEnd := strings.IndexFunc(str, func(c rune) bool {
+		return (c < '0' || c > '9') && c != '.'
+	})
+	number, unit := str, "b"
+	if numberEnd >= 0 {
+		number = str[:numberEnd]
+		unit = strings.ToLower(strings.TrimSpace(str[numberEnd:]))
+	}
+
+	multiplier, validUnit := byteUnits[unit]
+	value, err := strconv.ParseFloat(number, 64)
+	if !validUnit || err != nil {
+		return 0, fmt.Errorf("invalid size '%s'", str)
+	}
+	return int64(math.Round(value * multiplier)), nil
+}
+
+// FormatByteSize formats a number of bytes in a human readable way using IEC units, e.g. "1.5 GiB".
+func FormatByteSize(bytes int64) string {
+	return FormatByteSizeUnits(bytes, IECUnits)
+}
+
+// FormatByteSizeUnits formats a number of bytes in a human readable way using the given system of units,
+// e.g. "1.5 GiB" with IECUnits or "1.61 GB" with SIUnits. The result can be parsed back with ParseByteSize.
+func FormatByteSizeUnits(bytes int64, units string) string {
+	names, ok := formatUnits[units]
+	if !ok {
+		units, names = IECUnits, formatUnits[IECUnits]
+	}
+	value := float64(bytes)
+	unit := 0
+	for math.Abs(value) >= unitBases[units] && unit < len(names)-1 {
+		value /= unitBases[units]
+		unit++
+	}
+	number := strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+	return number + " " + names[unit]
+}
+
+// versionNumbers returns the numeric components of a version such as "0.95.0" or "v0.9.0a5".
+// Components after the first non numeric suffix, e.g. pre-release tags, are ignored.
+func versionNumbers(version string) []int {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	var numbers []int
+	for _, part := range strings.Split(version, ".") {
+		digitsEnd := strings.IndexFunc(part, func(c rune) bool {
+			return c < '0' || c > '9'
+		})
+		if digitsEnd == 0 {
+			break
+		}
+		digits := part
+		if digitsEnd > 0 {
+			digits = part[:digitsEnd]
+		}
+		number, err := strconv.Atoi(digits)
+		if err != nil {
+			break
+		}
+		numbers = append(numbers, number)
+		if digitsEnd > 0 {
+			break
+		}
+	}
+	return numbers
+}
+
+// CompareVersions compares two versions by their numeric components, returning -1, 0 or 1
+// if a is older than, equal to or newer than b. Pre-release tags are ignored, e.g. "0.9.0a5" equals "0.9.0".
+func CompareVersions(a, b string) int {
+	numbersA, numbersB := versionNumbers(a), versionNumbers(b)
+	for i := 0; i < len(numbersA) || i < len(numbersB); i++ {
+		var numberA, numberB int
+		if i < len(numbersA) {
+			numberA = numbersA[i]
+		}
+		if i < len(numbersB) {
+			numberB = numbersB[i]
+		}
+		if numberA != numberB {
+			if numberA < numberB {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// ShortVersion returns the major and minor components of a version, e.g. "0.9" for "0.9.0a5".
+// Returns the version unchanged if it cannot be parsed.
+func ShortVersion(version string) string {
+	numbers := versionNumbers(version)
+	if len(numbers) < 2 {
+		return version
+	}
+	return fmt.Sprintf("%d.%d", numbers[0], numbers[1])
+}
+
 // SplitLinesNoEmpty splits a given string into a list where each line is a list item.
 // In contrary to strings.Split, SplitLinesNoEmpty ignores empty lines.
 func SplitLinesNoEmpty(str string) []string {
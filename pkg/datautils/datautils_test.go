@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -211,3 +211,188 @@ func TestRemoveFromSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDate(t *testing.T) {
+	tests := map[string]struct {
+		arg       string
+		want      time.Time
+		wantError bool
+	}{
+		"date":           {arg: "2026-01-02", want: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
+		"date with time": {arg: "2026-01-02T10:30:00", want: time.Date(2026, 1, 2, 10, 30, 0, 0, time.UTC)},
+		"with space":     {arg: " 2026-01-02 10:30:00 ", want: time.Date(2026, 1, 2, 10, 30, 0, 0, time.UTC)},
+		"RFC3339":        {arg: "2026-01-02T10:30:00Z", want: time.Date(2026, 1, 2, 10, 30, 0, 0, time.UTC)},
+		"invalid":        {arg: "today", wantError: true},
+		"empty":          {arg: "", wantError: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseDate(test.arg)
+			if test.wantError {
+				if err == nil {
+					t.Errorf("Expected error, got %v", got)
+				}
+			} else if err != nil {
+				t.Errorf("Unexpected error: %s", err.Error())
+			} else if !got.Equal(test.want) {
+				t.Errorf("Expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := map[string]struct {
+		arg       string
+		want      time.Duration
+		wantError bool
+	}{
+		"days":           {arg: "30d", want: 30 * 24 * time.Hour},
+		"weeks":          {arg: "2w", want: 14 * 24 * time.Hour},
+		"fractional day": {arg: "1.5d", want: 36 * time.Hour},
+		"hours":          {arg: "12h", want: 12 * time.Hour},
+		"minutes":        {arg: "90m", want: 90 * time.Minute},
+		"negative":       {arg: "-1d", wantError: true},
+		"no unit":        {arg: "30", wantError: true},
+		"invalid":        {arg: "abcd", wantError: true},
+		"empty":          {arg: "", wantError: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseDuration(test.arg)
+			if test.wantError {
+				if err == nil {
+					t.Errorf("Expected error, got %v", got)
+				}
+			} else if err != nil {
+				t.Errorf("Unexpected error: %s", err.Error())
+			} else if got != test.want {
+				t.Errorf("Expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseByteSize(t *testing.T) {
+	tests := map[string]struct {
+		arg       string
+		want      int64
+		wantError bool
+	}{
+		"bytes":          {arg: "512", want: 512},
+		"bytes unit":     {arg: "512B", want: 512},
+		"bytes word":     {arg: "512 Bytes", want: 512},
+		"iec":            {arg: "50GiB", want: 50 << 30},
+		"si":             {arg: "2 MB", want: 2000000},
+		"fractional":     {arg: "1.5KiB", want: 1536},
+		"case":           {arg: "1kib", want: 1024},
+		"invalid unit":   {arg: "10 parsecs", wantError: true},
+		"invalid number": {arg: "GiB", wantError: true},
+		"empty":          {arg: "", wantError: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseByteSize(test.arg)
+			if test.wantError {
+				if err == nil {
+					t.Errorf("Expected error, got %d", got)
+				}
+			} else if err != nil {
+				t.Errorf("Unexpected error: %s", err.Error())
+			} else if got != test.want {
+				t.Errorf("Expected %d, got %d", test.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatByteSize(t *testing.T) {
+	tests := map[string]struct {
+		arg  int64
+		want string
+	}{
+		"zero":      {arg: 0, want: "0 Bytes"},
+		"bytes":     {arg: 512, want: "512 Bytes"},
+		"kibibytes": {arg: 1024, want: "1 KiB"},
+		"decimals":  {arg: 1935, want: "1.89 KiB"},
+		"gibibytes": {arg: 50 << 30, want: "50 GiB"},
+		"biggest":   {arg: 2048 << 50, want: "2048 PiB"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := FormatByteSize(test.arg)
+			if got != test.want {
+				t.Errorf("Expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatByteSizeUnits(t *testing.T) {
+	tests := map[string]struct {
+		arg   int64
+		units string
+		want  string
+	}{
+		"iec":           {arg: 1536, units: IECUnits, want: "1.5 KiB"},
+		"si":            {arg: 1536, units: SIUnits, want: "1.54 kB"},
+		"si bytes":      {arg: 999, units: SIUnits, want: "999 Bytes"},
+		"si gigabytes":  {arg: 50 << 30, units: SIUnits, want: "53.69 GB"},
+		"unknown units": {arg: 1024, units: "metric", want: "1 KiB"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := FormatByteSizeUnits(test.arg, test.units)
+			if got != test.want {
+				t.Errorf("Expected %s, got %s", test.want, got)
+			}
+			if size, err := ParseByteSize(got); err != nil {
+				t.Errorf("Could not parse %s back: %s", got, err.Error())
+			} else if diff := size - test.arg; diff > test.arg/100 || -diff > test.arg/100 {
+				t.Errorf("Expected %s to be parsed back to about %d, got %d", got, test.arg, size)
+			}
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "0.95.0", b: "0.95.0", want: 0},
+		{a: "0.9.3", b: "0.95.0", want: -1},
+		{a: "0.95.1", b: "0.95.0", want: 1},
+		{a: "0.9.0a5", b: "0.9.0", want: 0},
+		{a: "v1.0", b: "0.95.0", want: 1},
+		{a: "0.95", b: "0.95.0", want: 0},
+		{a: "0.10.0", b: "0.9.0", want: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" vs "+test.b, func(t *testing.T) {
+			got := CompareVersions(test.a, test.b)
+			if got != test.want {
+				t.Errorf("Expected %d, got %d", test.want, got)
+			}
+		})
+	}
+}
+
+func TestShortVersion(t *testing.T) {
+	tests := map[string]string{
+		"0.9.0a5": "0.9",
+		"0.95.0":  "0.95",
+		"v1.2.3":  "1.2",
+		"dev":     "dev",
+	}
+
+	for version, want := range tests {
+		t.Run(version, func(t *testing.T) {
+			got := ShortVersion(version)
+			if got != want {
+				t.Errorf("Expected '%s', got '%s'", want, got)
+			}
+		})
+	}
+}
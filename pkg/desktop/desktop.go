@@ -0,0 +1,89 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+// Package desktop interacts with the desktop environment of the user, e.g. the web browser and the clipboard.
+package desktop
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// command is an external program and its arguments.
+type command struct {
+	name string
+	args []string
+}
+
+// openURLCommands returns the commands that can open a URL in the default browser, for the given OS.
+func openURLCommands(goos, url string) []command {
+	switch goos {
+	case "darwin":
+		return []command{{"open", []string{url}}}
+	case "windows":
+		return []command{{"rundll32", []string{"url.dll,FileProtocolHandler", url}}}
+	default:
+		return []command{
+			{"xdg-open", []string{url}},
+			{"wslview", []string{url}},
+		}
+	}
+}
+
+// clipboardCommands returns the commands that can copy their standard input to the clipboard, for the given OS.
+func clipboardCommands(goos string) []command {
+	switch goos {
+	case "darwin":
+		return []command{{"pbcopy", nil}}
+	case "windows":
+		return []command{{"clip", nil}}
+	default:
+		return []command{
+			{"wl-copy", nil},
+			{"xclip", []string{"-selection", "clipboard"}},
+			{"xsel", []string{"--clipboard", "--input"}},
+		}
+	}
+}
+
+// OpenURL opens the URL in the default web browser of the user.
+func OpenURL(url string) error {
+	return runFirstAvailable(openURLCommands(runtime.GOOS, url), "", "open the browser")
+}
+
+// CopyToClipboard copies the text to the clipboard of the user.
+func CopyToClipboard(text string) error {
+	return runFirstAvailable(clipboardCommands(runtime.GOOS), text, "copy to the clipboard")
+}
+
+// runFirstAvailable runs the first of the commands that is installed, passing input as its standard input.
+func runFirstAvailable(commands []command, input, action string) error {
+	var names []string
+	for _, c := range commands {
+		path, err := exec.LookPath(c.name)
+		if err != nil {
+			names = append(names, c.name)
+			continue
+		}
+		cmd := exec.Command(path, c.args...)
+		if input != "" {
+			cmd.Stdin = strings.NewReader(input)
+		}
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("could not %s: %s", action, err.Error())
+		}
+		return nil
+	}
+	return fmt.Errorf(
+		"could not %s, none of these programs is installed: %s",
+		action,
+		strings.Join(names, ", "),
+	)
+}
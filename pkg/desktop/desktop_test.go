@@ -0,0 +1,68 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package desktop
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOpenURLCommands(t *testing.T) {
+	tests := map[string]string{
+		"darwin":  "open",
+		"windows": "rundll32",
+		"linux":   "xdg-open",
+	}
+	for goos, want := range tests {
+		t.Run(goos, func(t *testing.T) {
+			commands := openURLCommands(goos, "https://reana.cern.ch")
+			if commands[0].name != want {
+				t.Errorf("Expected '%s', got '%s'", want, commands[0].name)
+			}
+			args := commands[0].args
+			if args[len(args)-1] != "https://reana.cern.ch" {
+				t.Errorf("Expected URL as last argument, got %v", args)
+			}
+		})
+	}
+}
+
+func TestRunFirstAvailable(t *testing.T) {
+	dir := t.TempDir()
+	output := filepath.Join(dir, "output")
+	script := filepath.Join(dir, "fake-copy")
+	content := "#!/bin/sh\ncat > " + output + "\n"
+	if err := os.WriteFile(script, []byte(content), 0o755); err != nil {
+		t.Fatalf("Error while creating script: %s", err.Error())
+	}
+
+	err := runFirstAvailable(
+		[]command{{"not-installed-program", nil}, {script, nil}},
+		"https://reana.cern.ch",
+		"copy to the clipboard",
+	)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+	data, err := os.ReadFile(output)
+	if err != nil || string(data) != "https://reana.cern.ch" {
+		t.Errorf("Expected input to be passed to the command, got '%s', %v", data, err)
+	}
+
+	err = runFirstAvailable(
+		[]command{{"not-installed-program", nil}},
+		"",
+		"open the browser",
+	)
+	if err == nil || !strings.Contains(err.Error(), "none of these programs is installed: not-installed-program") {
+		t.Errorf("Expected missing program error, got %v", err)
+	}
+}
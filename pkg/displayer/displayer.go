@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -10,10 +10,13 @@ under the terms of the MIT License; see LICENSE file for more details.
 package displayer
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
 	"io"
 	"reanahub/reana-client-go/pkg/config"
+	"strconv"
+	"strings"
 
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
@@ -56,6 +59,47 @@ var ResourceHealthToColor = map[string]text.Color{
 // DisplayTable takes a header and the respective rows, and formats them in a table.
 // Instead of writing to stdout, it uses the provided io.Writer.
 func DisplayTable[T any](header []string, rows [][]T, out io.Writer) {
+	renderTable(header, rows, nil, true, out)
+}
+
+// TableStream displays a table whose rows are given in batches, e.g. page by page, so that they do
+// not need to be kept in memory. The width of the columns is determined by the header and the first
+// batch; the rows of the following batches are aligned on it, unless their values are wider.
+type TableStream[T any] struct {
+	header []string
+	out    io.Writer
+	widths []int
+}
+
+// NewTableStream creates a table stream with the given header, writing to out.
+func NewTableStream[T any](header []string, out io.Writer) *TableStream[T] {
+	return &TableStream[T]{header: header, out: out}
+}
+
+// Append displays a batch of rows. The header is displayed with the first batch, even if it is empty.
+func (s *TableStream[T]) Append(rows [][]T) {
+	if s.widths == nil {
+		s.widths = make([]int, len(s.header))
+		for i, h := range s.header {
+			s.widths[i] = text.RuneWidthWithoutEscSequences(strings.ToUpper(h))
+		}
+		for _, row := range rows {
+			for i, cell := range row {
+				if i < len(s.widths) {
+					s.widths[i] = max(s.widths[i], text.RuneWidthWithoutEscSequences(fmt.Sprint(cell)))
+				}
+			}
+		}
+		renderTable(s.header, rows, s.widths, true, s.out)
+		return
+	}
+	if len(rows) > 0 {
+		renderTable(s.header, rows, s.widths, false, s.out)
+	}
+}
+
+// renderTable renders the rows in a table, with columns at least as wide as minWidths if given.
+func renderTable[T any](header []string, rows [][]T, minWidths []int, showHeader bool, out io.Writer) {
 	// Convert to table.Row type
 	rowList := make([]table.Row, len(rows))
 	for i, row := range rows {
@@ -66,14 +110,15 @@ func DisplayTable[T any](header []string, rows [][]T, out io.Writer) {
 		rowList[i] = tableRow
 	}
 
-	headerRow := make(table.Row, len(header))
-	for i, h := range header {
-		headerRow[i] = h
-	}
-
 	t := table.NewWriter()
 	t.SetOutputMirror(out)
-	t.AppendHeader(headerRow)
+	if showHeader {
+		headerRow := make(table.Row, len(header))
+		for i, h := range header {
+			headerRow[i] = h
+		}
+		t.AppendHeader(headerRow)
+	}
 	t.AppendRows(rowList)
 
 	t.Style().Options.DrawBorder = false
@@ -83,11 +128,15 @@ func DisplayTable[T any](header []string, rows [][]T, out io.Writer) {
 	t.Style().Box.MiddleVertical = "   "
 	var columnConfig []table.ColumnConfig
 	for i := range header {
-		columnConfig = append(columnConfig, table.ColumnConfig{
+		column := table.ColumnConfig{
 			Number:      i + 1,
 			Align:       text.AlignLeft,
 			AlignHeader: text.AlignLeft,
-		})
+		}
+		if i < len(minWidths) {
+			column.WidthMin = minWidths[i]
+		}
+		columnConfig = append(columnConfig, column)
 	}
 	t.SetColumnConfigs(columnConfig)
 	t.Render()
@@ -109,6 +158,43 @@ func DisplayJsonOutput(output any, out io.Writer) error {
 	return nil
 }
 
+// JsonStream displays a JSON array whose items are given one at a time, so that they do not need
+// to be kept in memory. The output is the same as DisplayJsonOutput with the whole array.
+type JsonStream struct {
+	out   io.Writer
+	count int
+}
+
+// NewJsonStream creates a JSON array stream writing to out.
+func NewJsonStream(out io.Writer) *JsonStream {
+	return &JsonStream{out: out}
+}
+
+// Write displays an item of the array. The item should be compatible with json.Marshal.
+func (s *JsonStream) Write(item any) error {
+	byteArray, err := json.MarshalIndent(item, "  ", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to display json output:\n%v", err)
+	}
+	separator := ",\n  "
+	if s.count == 0 {
+		separator = "[\n  "
+	}
+	s.count++
+	_, err = fmt.Fprint(s.out, separator+string(byteArray))
+	return err
+}
+
+// Close ends the array.
+func (s *JsonStream) Close() error {
+	end := "\n]\n"
+	if s.count == 0 {
+		end = "[]\n"
+	}
+	_, err := fmt.Fprint(s.out, end)
+	return err
+}
+
 // DisplayMessage takes a message, a messageType (e.g. success or error) and displays it according to the color
 // associated with the messageType and whether it is indented or not.
 func DisplayMessage(
@@ -143,3 +229,66 @@ func PrintColorable(str string, out io.Writer, colorOptions ...text.Color) {
 	colors = append(colors, colorOptions...)
 	fmt.Fprint(out, colors.Sprint(str))
 }
+
+// AskConfirmation displays the given question and reads the answer from in.
+// Returns true only if the user answers "y" or "yes" (case insensitive).
+func AskConfirmation(question string, in io.Reader, out io.Writer) bool {
+	fmt.Fprintf(out, "%s %s [y/N]: ", config.LeadingMark, question)
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && answer == "" {
+		fmt.Fprintln(out)
+		return false
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// AskChoice displays the numbered choices and asks the user to pick one of them, reading the answer from in.
+// An empty answer picks the first choice. Returns the index of the chosen item.
+func AskChoice(question string, choices []string, in io.Reader, out io.Writer) (int, error) {
+	for i, choice := range choices {
+		fmt.Fprintf(out, "  [%d] %s\n", i+1, choice)
+	}
+	fmt.Fprintf(out, "%s %s [1]: ", config.LeadingMark, question)
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	answer = strings.TrimSpace(answer)
+	if err != nil && answer == "" {
+		fmt.Fprintln(out)
+		return 0, nil
+	}
+	if answer == "" {
+		return 0, nil
+	}
+	index, err := strconv.Atoi(answer)
+	if err != nil || index < 1 || index > len(choices) {
+		return 0, fmt.Errorf(
+			"invalid choice '%s', please enter a number between 1 and %d",
+			answer,
+			len(choices),
+		)
+	}
+	return index - 1, nil
+}
+
+// sparklineBars characters used to draw sparklines, from the lowest to the highest value.
+var sparklineBars = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline returns a single line chart of the given values, scaled from zero to upper.
+// If upper is not positive, the values are scaled to their maximum instead.
+func Sparkline(values []float64, upper float64) string {
+	for _, value := range values {
+		if value > upper {
+			upper = value
+		}
+	}
+
+	var builder strings.Builder
+	for _, value := range values {
+		index := 0
+		if upper > 0 && value > 0 {
+			index = int(value / upper * float64(len(sparklineBars)-1))
+		}
+		builder.WriteRune(sparklineBars[index])
+	}
+	return builder.String()
+}
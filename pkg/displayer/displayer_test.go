@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -141,6 +141,71 @@ func TestDisplayJsonOutput(t *testing.T) {
 	}
 }
 
+func TestTableStream(t *testing.T) {
+	buf := new(bytes.Buffer)
+	stream := NewTableStream[string]([]string{"name", "size"}, buf)
+	stream.Append([][]string{{"data.txt", "10"}, {"plot.png", "2048"}})
+	stream.Append([][]string{})
+	stream.Append([][]string{{"a.C", "1"}})
+
+	expected := []string{
+		"NAME       SIZE",
+		"data.txt   10  ",
+		"plot.png   2048",
+		"a.C        1   ",
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %d lines, got: '%s'", len(expected), buf.String())
+	}
+	for i, line := range lines {
+		if strings.TrimRight(line, " ") != strings.TrimRight(expected[i], " ") ||
+			len(line) < len(strings.TrimRight(expected[i], " ")) {
+			t.Errorf("Expected line '%s', got: '%s'", expected[i], line)
+		}
+	}
+
+	single := new(bytes.Buffer)
+	NewTableStream[string]([]string{"name", "size"}, single).
+		Append([][]string{{"data.txt", "10"}, {"plot.png", "2048"}})
+	table := new(bytes.Buffer)
+	DisplayTable([]string{"name", "size"}, [][]string{{"data.txt", "10"}, {"plot.png", "2048"}}, table)
+	if single.String() != table.String() {
+		t.Errorf("Expected a single batch to be displayed as '%s', got: '%s'", table.String(), single.String())
+	}
+}
+
+func TestJsonStream(t *testing.T) {
+	tests := map[string][]any{
+		"no items":  {},
+		"one item":  {map[string]any{"name": "data.txt", "size": 10}},
+		"two items": {map[string]any{"name": "data.txt"}, []int{1, 2}},
+	}
+
+	for name, items := range tests {
+		t.Run(name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			stream := NewJsonStream(buf)
+			for _, item := range items {
+				if err := stream.Write(item); err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+			}
+			if err := stream.Close(); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			expected := new(bytes.Buffer)
+			if err := DisplayJsonOutput(items, expected); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if buf.String() != expected.String() {
+				t.Errorf("Expected: '%s', got: '%s'", expected.String(), buf.String())
+			}
+		})
+	}
+}
+
 func TestDisplayMessage(t *testing.T) {
 	tests := map[string]struct {
 		msg      string
@@ -255,3 +320,94 @@ func TestPrintColorable(t *testing.T) {
 		})
 	}
 }
+
+func TestAskConfirmation(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		want  bool
+	}{
+		"yes":          {input: "yes\n", want: true},
+		"short yes":    {input: "y\n", want: true},
+		"upper case":   {input: "Y\n", want: true},
+		"no newline":   {input: "y", want: true},
+		"no":           {input: "n\n", want: false},
+		"empty answer": {input: "\n", want: false},
+		"no input":     {input: "", want: false},
+		"other answer": {input: "maybe\n", want: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			got := AskConfirmation("Proceed?", strings.NewReader(test.input), buf)
+			if got != test.want {
+				t.Errorf("Expected %t, got %t", test.want, got)
+			}
+			if !strings.Contains(buf.String(), "Proceed? [y/N]: ") {
+				t.Errorf("Expected question in output, got '%s'", buf.String())
+			}
+		})
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	tests := map[string]struct {
+		values []float64
+		upper  float64
+		want   string
+	}{
+		"empty":           {values: nil, upper: 10, want: ""},
+		"scaled to upper": {values: []float64{0, 5, 10}, upper: 10, want: "▁▄█"},
+		"scaled to max":   {values: []float64{1, 2, 4}, upper: 0, want: "▂▄█"},
+		"above upper":     {values: []float64{10, 20}, upper: 10, want: "▄█"},
+		"zero values":     {values: []float64{0, 0}, upper: 0, want: "▁▁"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := Sparkline(test.values, test.upper)
+			if got != test.want {
+				t.Errorf("Expected '%s', got '%s'", test.want, got)
+			}
+		})
+	}
+}
+
+func TestAskChoice(t *testing.T) {
+	choices := []string{"first", "second", "third"}
+	tests := map[string]struct {
+		input     string
+		want      int
+		wantError bool
+	}{
+		"number":       {input: "2\n", want: 1},
+		"no newline":   {input: "3", want: 2},
+		"empty answer": {input: "\n", want: 0},
+		"no input":     {input: "", want: 0},
+		"out of range": {input: "4\n", wantError: true},
+		"not a number": {input: "second\n", wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			got, err := AskChoice("Pick one", choices, strings.NewReader(test.input), buf)
+			if test.wantError {
+				if err == nil {
+					t.Errorf("Expected error, got %d", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Got unexpected error: %s", err.Error())
+			}
+			if got != test.want {
+				t.Errorf("Expected %d, got %d", test.want, got)
+			}
+			if !strings.Contains(buf.String(), "[2] second") ||
+				!strings.Contains(buf.String(), "Pick one [1]: ") {
+				t.Errorf("Expected choices and question in output, got '%s'", buf.String())
+			}
+		})
+	}
+}
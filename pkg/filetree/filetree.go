@@ -0,0 +1,150 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+// Package filetree builds the directory hierarchy of a workspace from the list of its files.
+package filetree
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// File is a file of a workspace.
+type File struct {
+	Path         string
+	Size         int64
+	LastModified string
+}
+
+// Node is a file or a directory of the tree. The size, the number of files and the last modification
+// date of a directory are aggregated over all the files below it.
+type Node struct {
+	Name         string  `json:"name"`
+	Directory    bool    `json:"directory"`
+	Size         int64   `json:"size"`
+	Files        int     `json:"files"`
+	LastModified string  `json:"last-modified"`
+	Children     []*Node `json:"children,omitempty"`
+}
+
+// Build returns the root directory of the tree containing the given files, named ".".
+// The children of each directory are sorted by name.
+func Build(files []File) *Node {
+	root := &Node{Name: ".", Directory: true}
+	for _, file := range files {
+		path := strings.Trim(file.Path, "/")
+		if path == "" {
+			continue
+		}
+		parts := strings.Split(path, "/")
+
+		node := root
+		for _, part := range parts[:len(parts)-1] {
+			node.add(file)
+			node = node.directory(part)
+		}
+		node.add(file)
+		node.Children = append(node.Children, &Node{
+			Name:         parts[len(parts)-1],
+			Size:         file.Size,
+			Files:        1,
+			LastModified: file.LastModified,
+		})
+	}
+	root.sort()
+	return root
+}
+
+// Prune returns a copy of the tree in which the directories deeper than depth are collapsed,
+// i.e. have no children. A depth of 0 or less keeps the whole tree.
+func (n *Node) Prune(depth int) *Node {
+	if depth <= 0 {
+		return n
+	}
+	return n.prune(depth)
+}
+
+// prune returns a copy of the node keeping depth levels of descendants.
+func (n *Node) prune(depth int) *Node {
+	pruned := *n
+	pruned.Children = nil
+	if depth > 0 {
+		for _, child := range n.Children {
+			pruned.Children = append(pruned.Children, child.prune(depth-1))
+		}
+	}
+	return &pruned
+}
+
+// Render returns the lines displaying the tree, with the size of each file and directory formatted
+// by formatSize. Directories end with a slash and show the number of files they contain.
+func (n *Node) Render(formatSize func(int64) string) []string {
+	lines := []string{n.label(formatSize)}
+	return n.renderChildren("", formatSize, lines)
+}
+
+// renderChildren appends the lines of the children of the node, each line starting with prefix.
+func (n *Node) renderChildren(prefix string, formatSize func(int64) string, lines []string) []string {
+	for i, child := range n.Children {
+		branch, indent := "├── ", "│   "
+		if i == len(n.Children)-1 {
+			branch, indent = "└── ", "    "
+		}
+		lines = append(lines, prefix+branch+child.label(formatSize))
+		lines = child.renderChildren(prefix+indent, formatSize, lines)
+	}
+	return lines
+}
+
+// label returns the name of the node followed by its size, and its number of files for directories.
+func (n *Node) label(formatSize func(int64) string) string {
+	if !n.Directory {
+		return fmt.Sprintf("%s (%s)", n.Name, formatSize(n.Size))
+	}
+	name := n.Name
+	if name != "." {
+		name += "/"
+	}
+	files := "files"
+	if n.Files == 1 {
+		files = "file"
+	}
+	return fmt.Sprintf("%s (%s, %d %s)", name, formatSize(n.Size), n.Files, files)
+}
+
+// add accounts for a file below the node.
+func (n *Node) add(file File) {
+	n.Size += file.Size
+	n.Files++
+	if file.LastModified > n.LastModified {
+		n.LastModified = file.LastModified
+	}
+}
+
+// directory returns the child directory with the given name, creating it if needed.
+func (n *Node) directory(name string) *Node {
+	for _, child := range n.Children {
+		if child.Directory && child.Name == name {
+			return child
+		}
+	}
+	child := &Node{Name: name, Directory: true}
+	n.Children = append(n.Children, child)
+	return child
+}
+
+// sort sorts the children of the node and of all its descendants by name.
+func (n *Node) sort() {
+	sort.SliceStable(n.Children, func(i, j int) bool {
+		return n.Children[i].Name < n.Children[j].Name
+	})
+	for _, child := range n.Children {
+		child.sort()
+	}
+}
@@ -0,0 +1,107 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package filetree
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+var testFiles = []File{
+	{Path: "results/plots/b.png", Size: 300, LastModified: "2022-07-11T13:30:17"},
+	{Path: "code/gendata.C", Size: 100, LastModified: "2022-07-11T12:50:33"},
+	{Path: "results/data.root", Size: 200, LastModified: "2022-07-11T13:00:00"},
+	{Path: "/reana.yaml", Size: 10, LastModified: "2022-07-10T09:00:00"},
+}
+
+func formatSize(size int64) string {
+	return strconv.FormatInt(size, 10)
+}
+
+func TestBuild(t *testing.T) {
+	root := Build(testFiles)
+	if root.Size != 610 || root.Files != 4 || root.LastModified != "2022-07-11T13:30:17" {
+		t.Errorf(
+			"unexpected root aggregates: size %d, files %d, last modified %s",
+			root.Size, root.Files, root.LastModified,
+		)
+	}
+
+	results := root.Children[2]
+	if results.Name != "results" || !results.Directory || results.Size != 500 || results.Files != 2 {
+		t.Errorf("unexpected results directory: %+v", results)
+	}
+	if results.LastModified != "2022-07-11T13:30:17" {
+		t.Errorf("expected results to be last modified by its plot, got %s", results.LastModified)
+	}
+}
+
+func TestRender(t *testing.T) {
+	want := []string{
+		". (610, 4 files)",
+		"├── code/ (100, 1 file)",
+		"│   └── gendata.C (100)",
+		"├── reana.yaml (10)",
+		"└── results/ (500, 2 files)",
+		"    ├── data.root (200)",
+		"    └── plots/ (300, 1 file)",
+		"        └── b.png (300)",
+	}
+	got := Build(testFiles).Render(formatSize)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected tree\n%v\ngot\n%v", want, got)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	tests := map[string]struct {
+		depth int
+		want  []string
+	}{
+		"first level": {
+			depth: 1,
+			want: []string{
+				". (610, 4 files)",
+				"├── code/ (100, 1 file)",
+				"├── reana.yaml (10)",
+				"└── results/ (500, 2 files)",
+			},
+		},
+		"two levels": {
+			depth: 2,
+			want: []string{
+				". (610, 4 files)",
+				"├── code/ (100, 1 file)",
+				"│   └── gendata.C (100)",
+				"├── reana.yaml (10)",
+				"└── results/ (500, 2 files)",
+				"    ├── data.root (200)",
+				"    └── plots/ (300, 1 file)",
+			},
+		},
+		"whole tree": {
+			depth: 0,
+			want:  Build(testFiles).Render(formatSize),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			root := Build(testFiles)
+			got := root.Prune(test.depth).Render(formatSize)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("expected tree\n%v\ngot\n%v", test.want, got)
+			}
+			if len(root.Render(formatSize)) != 8 {
+				t.Errorf("expected the original tree not to be modified")
+			}
+		})
+	}
+}
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022 CERN.
+Copyright (C) 2022, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -10,10 +10,20 @@ under the terms of the MIT License; see LICENSE file for more details.
 package fileutils
 
 import (
+	"archive/tar"
+	"compress/gzip"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
 )
 
+// unsafeFileNameChars matches the characters that should not be used in file names.
+var unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
 // CreateFile provides a way to create a new file ensuring the file path is present.
 func CreateFile(name string) (*os.File, error) {
 	if err := os.MkdirAll(filepath.Dir(name), os.ModePerm); err != nil {
@@ -21,3 +31,77 @@ func CreateFile(name string) (*os.File, error) {
 	}
 	return os.Create(name)
 }
+
+// SanitizeFileName replaces the characters of name that are not letters, digits, dots, dashes
+// or underscores by underscores, so that it can be safely used as a file name.
+func SanitizeFileName(name string) string {
+	name = strings.Trim(unsafeFileNameChars.ReplaceAllString(name, "_"), ".")
+	if name == "" {
+		return "_"
+	}
+	return name
+}
+
+// WriteFiles writes the given files in dir, creating the missing directories.
+// The files are given by their path relative to dir, using slashes as separators.
+func WriteFiles(dir string, files map[string][]byte) error {
+	for _, name := range sortedNames(files) {
+		file, err := CreateFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			return err
+		}
+		if _, err := file.Write(files[name]); err != nil {
+			file.Close()
+			return err
+		}
+		if err := file.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteTarGz writes the given files in a gzip-compressed tar archive, inside the root directory.
+// The files are given by their path relative to root, using slashes as separators.
+func WriteTarGz(archivePath, root string, files map[string][]byte) error {
+	file, err := CreateFile(archivePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+	now := time.Now()
+	for _, name := range sortedNames(files) {
+		header := &tar.Header{
+			Name:    path.Join(root, name),
+			Mode:    0o644,
+			Size:    int64(len(files[name])),
+			ModTime: now,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tarWriter.Write(files[name]); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// sortedNames returns the names of the files in alphabetical order.
+func sortedNames(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
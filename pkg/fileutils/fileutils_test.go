@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022 CERN.
+Copyright (C) 2022, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -9,7 +9,13 @@ under the terms of the MIT License; see LICENSE file for more details.
 package fileutils
 
 import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
 	"path"
+	"path/filepath"
+	"reflect"
 	"testing"
 )
 
@@ -26,3 +32,84 @@ func TestCreateFile(t *testing.T) {
 		t.Errorf("Expected %s, got %s", filePath, got)
 	}
 }
+
+func TestSanitizeFileName(t *testing.T) {
+	tests := map[string]string{
+		"fit":            "fit",
+		"fit-1.2_a":      "fit-1.2_a",
+		"step/with pipe": "step_with_pipe",
+		"..":             "_",
+		"":               "_",
+	}
+	for name, want := range tests {
+		if got := SanitizeFileName(name); got != want {
+			t.Errorf("SanitizeFileName(%q): expected %q, got %q", name, want, got)
+		}
+	}
+}
+
+func TestWriteFiles(t *testing.T) {
+	tmpdir := t.TempDir()
+	files := map[string][]byte{
+		"workflow.log":     []byte("workflow logs"),
+		"jobs/fit-1.log":   []byte("fit logs"),
+		"service/dask.log": []byte("dask logs"),
+	}
+	if err := WriteFiles(tmpdir, files); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	for name, content := range files {
+		got, err := os.ReadFile(filepath.Join(tmpdir, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if string(got) != string(content) {
+			t.Errorf("Expected %s to contain %q, got %q", name, content, got)
+		}
+	}
+}
+
+func TestWriteTarGz(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "logs.tar.gz")
+	files := map[string][]byte{
+		"workflow.log":   []byte("workflow logs"),
+		"jobs/fit-1.log": []byte("fit logs"),
+	}
+	if err := WriteTarGz(archivePath, "myanalysis", files); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	file, err := os.Open(archivePath)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer file.Close()
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	tarReader := tar.NewReader(gzipReader)
+
+	got := map[string]string{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		got[header.Name] = string(content)
+	}
+	want := map[string]string{
+		"myanalysis/workflow.log":   "workflow logs",
+		"myanalysis/jobs/fit-1.log": "fit logs",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected archive content %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,444 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package filterer
+
+import (
+	"errors"
+	"fmt"
+	"reanahub/reana-client-go/pkg/datautils"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/exp/slices"
+)
+
+// ValueType is the type of the values of a filter key, which defines how they are compared.
+type ValueType int
+
+const (
+	// StringValue values are compared as strings.
+	StringValue ValueType = iota
+	// NumberValue values are compared as numbers, e.g. 42 or 1.5.
+	NumberValue
+	// SizeValue values are compared as byte sizes, e.g. 512, 50GiB or 1.5GB.
+	SizeValue
+	// DateValue values are compared as dates, e.g. 2026-01-01 or 2026-01-01T12:00:00.
+	DateValue
+	// DurationValue values are compared as durations, e.g. 90s, 2h, 3d or a number of seconds.
+	DurationValue
+)
+
+// operators supported in the conditions of filter expressions, longest first.
+var operators = []string{"!=", ">=", "<=", "!~", "=", ">", "<", "~"}
+
+// Expression is a parsed filter expression, e.g. "size>1GiB and name~^test-".
+type Expression interface {
+	// Eval returns whether an item with the given values matches the expression.
+	// The values are mapped by filter key.
+	Eval(values map[string]string) bool
+	// keys returns the keys compared in the expression.
+	keys() []string
+}
+
+// condition is an expression comparing the value of a key, e.g. "size>1GiB".
+type condition struct {
+	key       string
+	operator  string
+	value     string
+	valueType ValueType
+	number    float64
+	date      time.Time
+	regexp    *regexp.Regexp
+}
+
+// logical is the conjunction or the disjunction of expressions.
+type logical struct {
+	and   bool
+	terms []Expression
+}
+
+// ParseExpression parses a filter expression. An expression is made of conditions such as
+// "size>1GiB", "created>=2026-01-01", "name~^test-" or "status!=deleted", which can be combined with
+// "and" and "or" and grouped with parentheses. The supported operators are '=', '!=', '>', '>=', '<',
+// '<=', '~' (matches the regular expression) and '!~'. The values are compared according to the types
+// of their keys, strings by default; values containing spaces can be quoted. Only the given keys and
+// those of types are valid.
+func ParseExpression(expression string, keys []string, types map[string]ValueType) (Expression, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter '%s': %s", expression, err.Error())
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("invalid filter '%s': empty expression", expression)
+	}
+	p := &parser{tokens: tokens, keys: keys, types: types}
+	expr, err := p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected '%s'", p.tokens[p.pos].text)
+	}
+	if err != nil {
+		var keyErr *invalidKeyError
+		if errors.As(err, &keyErr) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("invalid filter '%s': %s", expression, err.Error())
+	}
+	return expr, nil
+}
+
+// Eval returns whether the value of the key matches the condition.
+// Items without a valid value for the key are only matched by '!=' and '!~'.
+func (c *condition) Eval(values map[string]string) bool {
+	value, exists := values[c.key]
+	switch c.operator {
+	case "~":
+		return exists && c.regexp.MatchString(value)
+	case "!~":
+		return !exists || !c.regexp.MatchString(value)
+	}
+
+	cmp, ok := c.compare(value)
+	if !exists || !ok {
+		return c.operator == "!="
+	}
+	switch c.operator {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	default:
+		return cmp <= 0
+	}
+}
+
+// compare compares the given value with the one of the condition, according to the type of the key.
+// It returns false if the value is not valid for this type.
+func (c *condition) compare(value string) (int, bool) {
+	switch c.valueType {
+	case NumberValue, SizeValue, DurationValue:
+		number, err := parseNumber(value, c.valueType)
+		if err != nil {
+			return 0, false
+		}
+		return compareNumbers(number, c.number), true
+	case DateValue:
+		date, err := datautils.ParseDate(value)
+		if err != nil {
+			return 0, false
+		}
+		return date.Compare(c.date), true
+	default:
+		return strings.Compare(value, c.value), true
+	}
+}
+
+func (c *condition) keys() []string {
+	return []string{c.key}
+}
+
+// Eval returns whether all the terms match, for a conjunction, or any of them, for a disjunction.
+func (l *logical) Eval(values map[string]string) bool {
+	for _, term := range l.terms {
+		if term.Eval(values) != l.and {
+			return !l.and
+		}
+	}
+	return l.and
+}
+
+func (l *logical) keys() []string {
+	var keys []string
+	for _, term := range l.terms {
+		keys = append(keys, term.keys()...)
+	}
+	return keys
+}
+
+// equalities returns the key and the values of an expression made of equality conditions on a single key,
+// such as "status=running or status=finished", which can be evaluated by the server.
+func equalities(expr Expression) (string, []string, bool) {
+	switch e := expr.(type) {
+	case *condition:
+		return e.key, []string{e.value}, e.operator == "="
+	case *logical:
+		if e.and && len(e.terms) > 1 {
+			return "", nil, false
+		}
+		var key string
+		var values []string
+		for _, term := range e.terms {
+			termKey, termValues, ok := equalities(term)
+			if !ok || (key != "" && termKey != key) {
+				return "", nil, false
+			}
+			key = termKey
+			values = append(values, termValues...)
+		}
+		return key, values, key != ""
+	}
+	return "", nil, false
+}
+
+// token is a word of a filter expression.
+type token struct {
+	text string
+	// quoted is true if the token contains quotes, i.e. it can't be a keyword or a parenthesis.
+	quoted bool
+}
+
+// tokenize splits a filter expression into words and parentheses. Parentheses are only
+// tokens at the beginning of a word, or at its end if they are not balanced within the word
+// or if they follow the quotes of the word.
+func tokenize(expression string) ([]token, error) {
+	var words []token
+	var word strings.Builder
+	var quote rune
+	quoted, inWord := false, false
+	// closing counts the parentheses following the quotes of a quoted word, which end groups.
+	closing := 0
+	endWord := func() {
+		if inWord {
+			words = append(words, token{text: word.String(), quoted: quoted})
+			word.Reset()
+		}
+		for ; closing > 0; closing-- {
+			words = append(words, token{text: ")"})
+		}
+		quoted, inWord = false, false
+	}
+	for _, c := range expression {
+		switch {
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+			word.WriteRune(c)
+		case c == '"' || c == '\'':
+			word.WriteString(strings.Repeat(")", closing))
+			closing = 0
+			quote, quoted, inWord = c, true, true
+		case unicode.IsSpace(c):
+			endWord()
+		case c == '(' && !inWord:
+			words = append(words, token{text: "("})
+		case c == ')' && quoted:
+			closing++
+		default:
+			word.WriteString(strings.Repeat(")", closing))
+			closing = 0
+			word.WriteRune(c)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("unterminated quotes")
+	}
+	endWord()
+
+	var tokens []token
+	for _, w := range words {
+		closing := 0
+		if !w.quoted {
+			for strings.HasSuffix(w.text, ")") &&
+				strings.Count(w.text, ")") > strings.Count(w.text, "(") {
+				w.text = strings.TrimSuffix(w.text, ")")
+				closing++
+			}
+		}
+		if w.text != "" {
+			tokens = append(tokens, w)
+		}
+		for ; closing > 0; closing-- {
+			tokens = append(tokens, token{text: ")"})
+		}
+	}
+	return tokens, nil
+}
+
+// parser parses the tokens of a filter expression by recursive descent, "and" taking precedence over "or".
+type parser struct {
+	tokens []token
+	pos    int
+	keys   []string
+	types  map[string]ValueType
+}
+
+// invalidKeyError is returned when a condition uses a key that is not a valid filter.
+type invalidKeyError struct {
+	key  string
+	keys []string
+}
+
+func (e *invalidKeyError) Error() string {
+	return fmt.Sprintf(
+		"filter key '%s' is not valid\nAvailable filters are '%s'",
+		e.key,
+		strings.Join(e.keys, "', '"),
+	)
+}
+
+// parseOr parses a disjunction of conjunctions.
+func (p *parser) parseOr() (Expression, error) {
+	return p.parseLogical(false, p.parseAnd)
+}
+
+// parseAnd parses a conjunction of conditions.
+func (p *parser) parseAnd() (Expression, error) {
+	return p.parseLogical(true, p.parseTerm)
+}
+
+// parseLogical parses terms separated by "and" or "or" keywords.
+func (p *parser) parseLogical(and bool, parseTerm func() (Expression, error)) (Expression, error) {
+	keyword := "or"
+	if and {
+		keyword = "and"
+	}
+	term, err := parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	terms := []Expression{term}
+	for p.pos < len(p.tokens) && p.isKeyword(p.tokens[p.pos], keyword) {
+		p.pos++
+		term, err := parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+	if len(terms) == 1 {
+		return term, nil
+	}
+	return &logical{and: and, terms: terms}, nil
+}
+
+// parseTerm parses a condition or an expression between parentheses.
+func (p *parser) parseTerm() (Expression, error) {
+	if p.pos == len(p.tokens) {
+		return nil, errors.New("unexpected end of expression")
+	}
+	tok := p.tokens[p.pos]
+	p.pos++
+	if !tok.quoted && tok.text == "(" {
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.pos == len(p.tokens) || p.tokens[p.pos].quoted || p.tokens[p.pos].text != ")" {
+			return nil, errors.New("missing closing parenthesis")
+		}
+		p.pos++
+		return expr, nil
+	}
+	if !tok.quoted && (tok.text == ")" || p.isKeyword(tok, "and") || p.isKeyword(tok, "or")) {
+		return nil, fmt.Errorf("unexpected '%s'", tok.text)
+	}
+	return p.parseCondition(tok.text)
+}
+
+// parseCondition parses a condition such as "size>1GiB".
+func (p *parser) parseCondition(text string) (Expression, error) {
+	opStart := strings.IndexAny(text, "=!<>~")
+	if opStart <= 0 {
+		return nil, fmt.Errorf("'%s' is not a condition such as key=value or key>value", text)
+	}
+	key := strings.ToLower(text[:opStart])
+	if !p.isValidKey(key) {
+		return nil, &invalidKeyError{key: key, keys: p.validKeys()}
+	}
+	c := &condition{key: key, valueType: p.types[key]}
+	for _, operator := range operators {
+		if strings.HasPrefix(text[opStart:], operator) {
+			c.operator = operator
+			break
+		}
+	}
+	if c.operator == "" {
+		return nil, fmt.Errorf("unknown operator in '%s'", text)
+	}
+	c.value = text[opStart+len(c.operator):]
+
+	var err error
+	switch {
+	case c.operator == "~" || c.operator == "!~":
+		c.regexp, err = regexp.Compile(c.value)
+	case c.valueType == DateValue:
+		c.date, err = datautils.ParseDate(c.value)
+	case c.valueType != StringValue:
+		c.number, err = parseNumber(c.value, c.valueType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for '%s': %s", key, err.Error())
+	}
+	return c, nil
+}
+
+// isKeyword returns whether the token is the given keyword, regardless of its case.
+func (p *parser) isKeyword(tok token, keyword string) bool {
+	return !tok.quoted && strings.EqualFold(tok.text, keyword)
+}
+
+// isValidKey returns whether the key can be used in conditions.
+func (p *parser) isValidKey(key string) bool {
+	_, typed := p.types[key]
+	return typed || slices.Contains(p.keys, key)
+}
+
+// validKeys returns the keys that can be used in conditions.
+func (p *parser) validKeys() []string {
+	keys := append([]string{}, p.keys...)
+	for key := range p.types {
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// parseNumber parses a number, a byte size or a duration in seconds, according to the value type.
+func parseNumber(value string, valueType ValueType) (float64, error) {
+	switch valueType {
+	case SizeValue:
+		size, err := datautils.ParseByteSize(value)
+		return float64(size), err
+	case DurationValue:
+		if seconds, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+			return seconds, nil
+		}
+		duration, err := datautils.ParseDuration(value)
+		return duration.Seconds(), err
+	default:
+		number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number '%s'", value)
+		}
+		return number, nil
+	}
+}
+
+// compareNumbers returns -1, 0 or 1 if a is smaller than, equal to or greater than b.
+func compareNumbers(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
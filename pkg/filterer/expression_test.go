@@ -0,0 +1,206 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package filterer
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+func TestParseExpression(t *testing.T) {
+	keys := []string{"name", "status"}
+	types := map[string]ValueType{
+		"size":     SizeValue,
+		"created":  DateValue,
+		"duration": DurationValue,
+		"run":      NumberValue,
+	}
+	item := map[string]string{
+		"name":     "test-analysis",
+		"status":   "finished",
+		"size":     "2147483648",
+		"created":  "2026-03-01T10:00:00",
+		"duration": "5400",
+		"run":      "12",
+	}
+	tests := map[string]struct {
+		expression string
+		want       bool
+	}{
+		"equality":               {expression: "status=finished", want: true},
+		"inequality":             {expression: "status!=deleted", want: true},
+		"size greater":           {expression: "size>1GiB", want: true},
+		"size greater SI":        {expression: "size>=3GB", want: false},
+		"date":                   {expression: "created>=2026-01-01", want: true},
+		"date with time":         {expression: "created<2026-03-01T09:00:00", want: false},
+		"duration":               {expression: "duration>1h", want: true},
+		"duration in seconds":    {expression: "duration<=5400", want: true},
+		"number":                 {expression: "run>9", want: true},
+		"regular expression":     {expression: "name~^test-", want: true},
+		"negated regexp":         {expression: "name!~^test-", want: false},
+		"and":                    {expression: "status=finished and size<1GiB", want: false},
+		"or":                     {expression: "status=failed or size>1GiB", want: true},
+		"and before or":          {expression: "status=failed and size>1GiB or run=12", want: true},
+		"parentheses":            {expression: "status=failed and (size>1GiB or run=12)", want: false},
+		"nested parentheses":     {expression: "((status=finished))", want: true},
+		"keywords case":          {expression: "status=failed OR run=12", want: true},
+		"quoted value":           {expression: `name="test-analysis"`, want: true},
+		"quoted value in group":  {expression: `(name="test analysis" or run=1)`, want: false},
+		"regexp with group":      {expression: "name~^(test|prod)-", want: true},
+		"regexp group at end":    {expression: "(run=1 or name~(analysis))", want: true},
+		"missing value":          {expression: "user=bob", want: false},
+		"missing value inequal":  {expression: "user!=bob", want: true},
+		"uppercase key":          {expression: "STATUS=finished", want: true},
+		"value with equal signs": {expression: "name=a=b", want: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, err := ParseExpression(test.expression, append(keys, "user"), types)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if got := expr.Eval(item); got != test.want {
+				t.Errorf("expected %s to be %t, got %t", test.expression, test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseExpressionErrors(t *testing.T) {
+	types := map[string]ValueType{"size": SizeValue, "created": DateValue}
+	tests := map[string]struct {
+		expression string
+		wantError  string
+	}{
+		"invalid key": {
+			expression: "owner=bob",
+			wantError:  "filter key 'owner' is not valid\nAvailable filters are 'created', 'name', 'size'",
+		},
+		"invalid size": {
+			expression: "size>big",
+			wantError:  "invalid filter 'size>big': invalid value for 'size': invalid size 'big'",
+		},
+		"invalid date": {
+			expression: "created>today",
+			wantError:  "invalid filter 'created>today': invalid value for 'created': invalid date 'today'",
+		},
+		"invalid regular expression": {
+			expression: "name~[a",
+			wantError:  "invalid filter 'name~[a': invalid value for 'name'",
+		},
+		"not a condition": {
+			expression: "name",
+			wantError:  "invalid filter 'name': 'name' is not a condition such as key=value or key>value",
+		},
+		"missing condition": {
+			expression: "name=a and",
+			wantError:  "invalid filter 'name=a and': unexpected end of expression",
+		},
+		"missing parenthesis": {
+			expression: "(name=a or name=b",
+			wantError:  "invalid filter '(name=a or name=b': missing closing parenthesis",
+		},
+		"unexpected word": {
+			expression: "name=a b",
+			wantError:  "invalid filter 'name=a b': unexpected 'b'",
+		},
+		"unterminated quotes": {
+			expression: `name="a`,
+			wantError:  `invalid filter 'name="a': unterminated quotes`,
+		},
+		"empty": {
+			expression: " ",
+			wantError:  "invalid filter ' ': empty expression",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseExpression(test.expression, []string{"name"}, types)
+			if err == nil {
+				t.Fatalf("expected error for %s, got nil", test.expression)
+			}
+			if !strings.HasPrefix(err.Error(), test.wantError) {
+				t.Errorf("expected error '%s', got '%s'", test.wantError, err.Error())
+			}
+		})
+	}
+}
+
+func TestFiltersExpressions(t *testing.T) {
+	tests := map[string]struct {
+		inputFilters []string
+		wantJson     string
+		wantKeys     []string
+		match        map[string]string
+		wantMatch    bool
+	}{
+		"plain filters": {
+			inputFilters: []string{"name=test", "status=finished"},
+			wantJson:     `{"name":["test"],"status":["finished"]}`,
+			wantMatch:    true,
+		},
+		"plain filter with spaces": {
+			inputFilters: []string{"name=my analysis"},
+			wantJson:     `{"name":["my analysis"]}`,
+			wantMatch:    true,
+		},
+		"disjunction of equalities": {
+			inputFilters: []string{"status=running or status=finished"},
+			wantJson:     `{"status":["running","finished"]}`,
+			wantMatch:    true,
+		},
+		"client side expression": {
+			inputFilters: []string{"name=test", "size>1GiB or status!=finished"},
+			wantJson:     `{"name":["test"]}`,
+			wantKeys:     []string{"size", "status"},
+			match:        map[string]string{"size": "10", "status": "finished"},
+			wantMatch:    false,
+		},
+		"typed key equality": {
+			inputFilters: []string{"size=1KiB"},
+			wantKeys:     []string{"size"},
+			match:        map[string]string{"size": "1024"},
+			wantMatch:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			filters, err := NewTypedFilters(
+				nil,
+				[]string{"name", "status"},
+				map[string]ValueType{"size": SizeValue},
+				test.inputFilters,
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			json, err := filters.GetJson([]string{"name", "status"})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if json != test.wantJson {
+				t.Errorf("expected JSON %s, got %s", test.wantJson, json)
+			}
+			if filters.HasExpressions() != (len(test.wantKeys) > 0) {
+				t.Errorf("expected HasExpressions to be %t", len(test.wantKeys) > 0)
+			}
+			if keys := filters.ExpressionKeys(); !slices.Equal(keys, test.wantKeys) {
+				t.Errorf("expected expression keys %v, got %v", test.wantKeys, keys)
+			}
+			if got := filters.Match(test.match); got != test.wantMatch {
+				t.Errorf("expected Match to be %t, got %t", test.wantMatch, got)
+			}
+		})
+	}
+}
@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -25,18 +25,32 @@ type Filters struct {
 	MultiFilterKeys    []string // names (keys) of the multi value filters to be considered
 	singleValueFilters map[string]string
 	multiValueFilters  map[string][]string
+	types              map[string]ValueType // types of the keys that can be used in filter expressions
+	expressions        []Expression         // filter expressions evaluated on the client side
 }
 
 // NewFilters returns a new instance of Filters, with the given keys.
 // singleFilterKeys are the filters with only one value at a time, while multiFilterKeys can accumulate values.
 func NewFilters(
 	singleFilterKeys, multiFilterKeys, inputFilters []string,
+) (Filters, error) {
+	return NewTypedFilters(singleFilterKeys, multiFilterKeys, nil, inputFilters)
+}
+
+// NewTypedFilters returns a new instance of Filters like NewFilters, where types gives the types of the keys
+// compared in filter expressions. The keys of types that are neither single nor multi value filters can only
+// be used in filter expressions, which are evaluated on the client side.
+func NewTypedFilters(
+	singleFilterKeys, multiFilterKeys []string,
+	types map[string]ValueType,
+	inputFilters []string,
 ) (Filters, error) {
 	filters := Filters{
 		SingleFilterKeys:   singleFilterKeys,
 		MultiFilterKeys:    multiFilterKeys,
 		singleValueFilters: make(map[string]string),
 		multiValueFilters:  make(map[string][]string),
+		types:              types,
 	}
 	err := filters.AddFilters(inputFilters)
 	if err != nil {
@@ -45,7 +59,7 @@ func NewFilters(
 	return filters, nil
 }
 
-// AddFilters adds multiple filters, in the format 'key=value'. Works for both single and multi value filters.
+// AddFilters adds multiple filters, see AddFilter.
 func (f *Filters) AddFilters(filters []string) error {
 	for _, filter := range filters {
 		err := f.AddFilter(filter)
@@ -56,17 +70,41 @@ func (f *Filters) AddFilters(filters []string) error {
 	return nil
 }
 
-// AddFilter adds a filter, in the format 'key=value'. Works for both single and multi value filters.
+// AddFilter adds a filter, in the format 'key=value' or as a filter expression such as
+// 'size>1GiB and name~^test-', see ParseExpression. Equality conditions on a single or multi value
+// filter, possibly combined with 'or' for multi value filters, are kept as values of this filter,
+// so that the server can evaluate them. The other expressions are evaluated with Match.
 func (f *Filters) AddFilter(filter string) error {
-	key, value, err := f.getKeyAndValue(filter)
+	key, value, plainErr := f.getKeyAndValue(filter)
+	if plainErr != nil && !strings.ContainsAny(filter, "!<>~") {
+		return plainErr
+	}
+	keys := append(slices.Clone(f.SingleFilterKeys), f.MultiFilterKeys...)
+	expr, err := ParseExpression(filter, keys, f.types)
 	if err != nil {
-		return err
+		// Plain values can contain spaces or special characters, e.g. name=my file.
+		if plainErr != nil || !slices.Contains(keys, key) {
+			return err
+		}
+		return f.addValues(key, []string{value})
 	}
 
+	if key, values, ok := equalities(expr); ok {
+		isSingle := slices.Contains(f.SingleFilterKeys, key) && len(values) == 1
+		if isSingle || slices.Contains(f.MultiFilterKeys, key) {
+			return f.addValues(key, values)
+		}
+	}
+	f.expressions = append(f.expressions, expr)
+	return nil
+}
+
+// addValues adds the values of a single or multi value filter.
+func (f *Filters) addValues(key string, values []string) error {
 	if slices.Contains(f.SingleFilterKeys, key) {
-		f.singleValueFilters[key] = value
+		f.singleValueFilters[key] = values[0]
 	} else if slices.Contains(f.MultiFilterKeys, key) {
-		f.multiValueFilters[key] = append(f.multiValueFilters[key], value)
+		f.multiValueFilters[key] = append(f.multiValueFilters[key], values...)
 	} else {
 		return fmt.Errorf(
 			"filter key '%s' is not valid\nAvailable filters are '%s'",
@@ -77,6 +115,35 @@ func (f *Filters) AddFilter(filter string) error {
 	return nil
 }
 
+// HasExpressions returns whether some filters are expressions to be evaluated with Match.
+func (f Filters) HasExpressions() bool {
+	return len(f.expressions) > 0
+}
+
+// ExpressionKeys returns the keys compared in the filter expressions, e.g. to retrieve their values.
+func (f Filters) ExpressionKeys() []string {
+	var keys []string
+	for _, expr := range f.expressions {
+		for _, key := range expr.keys() {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+// Match returns whether an item with the given values, mapped by filter key, matches all the filter
+// expressions. The values of single and multi value filters are not considered.
+func (f Filters) Match(values map[string]string) bool {
+	for _, expr := range f.expressions {
+		if !expr.Eval(values) {
+			return false
+		}
+	}
+	return true
+}
+
 // GetSingle returns the value of a single value filter.
 func (f Filters) GetSingle(key string) (string, error) {
 	if !slices.Contains(f.SingleFilterKeys, key) {
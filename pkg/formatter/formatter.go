@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2023, 2025 CERN.
+Copyright (C) 2022, 2023, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -10,14 +10,12 @@ under the terms of the MIT License; see LICENSE file for more details.
 package formatter
 
 import (
-	"fmt"
 	"reanahub/reana-client-go/pkg/validator"
 	"strconv"
 	"strings"
 
 	"github.com/go-gota/gota/dataframe"
 	"github.com/go-gota/gota/series"
-	"golang.org/x/exp/slices"
 )
 
 // FormatFilter provides a centralized way of handling format options across the different commands.
@@ -78,72 +76,17 @@ func FormatDataFrame(
 	return df, nil
 }
 
-// SortDataFrame sorts the given dataFrame according to the sortColumn and whether the order is reversed.
-// The sortColumn must be included in the df header.
-func SortDataFrame(
-	df dataframe.DataFrame,
-	sortColumn string,
-	reverse bool,
-	readableToRaw map[string]int64,
-	humanReadable bool,
-) (dataframe.DataFrame, error) {
-	sortColumn = strings.ToLower(sortColumn)
-	if !slices.Contains(df.Names(), sortColumn) {
-		return df, fmt.Errorf("column '%s' does not exist", sortColumn)
-	}
-
-	if sortColumn == "run_number" {
-		runNumbers := df.Col("run_number").Records()
-
-		//Convert run_number string into integers and put those in a new
-		// temporary dataframe column, which will be used for sorting.
-		var sortableRunNumber []int
-		for _, v := range runNumbers {
-			parts := strings.Split(v, ".")
-			major, _ := strconv.Atoi(parts[0])
-			var minor int
-			if len(parts) > 1 {
-				minor, _ = strconv.Atoi(parts[1])
-			}
-			sortableVersion := major*1000 + minor
-			sortableRunNumber = append(sortableRunNumber, sortableVersion)
-		}
-
-		// Sort dataframe using sortable "sortable_run_number" column
-		df = df.Mutate(
-			series.New(sortableRunNumber, series.Int, "sortable_run_number"),
-		)
-		sortColumn = "sortable_run_number"
-	}
-
-	if sortColumn == "size" && humanReadable {
-		var sortableSize []int
-		for _, v := range df.Col("size").Records() {
-			sortableSize = append(sortableSize, int(readableToRaw[v]))
-		}
-		df = df.Mutate(series.New(sortableSize, series.Int, "sortable_size"))
-		sortColumn = "sortable_size"
-	}
-
-	sortedDF := df.Arrange(
-		dataframe.Order{Colname: sortColumn, Reverse: reverse},
-	)
-	if sortColumn == "sortable_run_number" || sortColumn == "sortable_size" {
-		// Remove temporary column used for sorting
-		sortedDF = sortedDF.Drop(sortColumn)
-	}
-
-	return sortedDF, nil
-}
-
 // DataFrameToStringData converts a given dataFrame to a 2D slice of strings.
-// Converts null values to "-".
+// Converts null values to "-" and formats floats without trailing zeros.
 func DataFrameToStringData(df dataframe.DataFrame) [][]string {
 	data := df.Records()[1:] // Ignore col names
 	for i, row := range data {
 		for j := range row {
-			if df.Elem(i, j).IsNA() {
+			elem := df.Elem(i, j)
+			if elem.IsNA() {
 				data[i][j] = "-"
+			} else if elem.Type() == series.Float {
+				data[i][j] = strconv.FormatFloat(elem.Float(), 'f', -1, 64)
 			}
 		}
 	}
@@ -0,0 +1,570 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package formatter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reanahub/reana-client-go/pkg/datautils"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+	"golang.org/x/exp/slices"
+)
+
+// aggregationFunctions functions that can be used in aggregations, e.g. "sum(size)".
+var aggregationFunctions = []string{"count", "sum", "avg", "min", "max"}
+
+// Report describes the columns of a custom report built from a data frame: the selected columns, which can
+// be renamed or computed, and the aggregations of the rows grouped by some columns.
+type Report struct {
+	Columns      []Column
+	GroupBy      []string
+	Aggregations []Aggregation
+}
+
+// Column is a column of a report, selected from the data frame or computed from its columns.
+type Column struct {
+	// Name is the name of the column in the data frame, or the name given to the computed column.
+	Name string
+	// Label is the displayed name of the column.
+	Label string
+	expr  arithmetic
+}
+
+// Aggregation is an aggregate of a column over the rows of each group, e.g. "sum(size)", or the number of
+// rows of each group, i.e. "count".
+type Aggregation struct {
+	Function string
+	Column   string
+}
+
+// ParseReport parses the columns, the group-by columns and the aggregations of a report.
+//
+// Each column is the name of a column of the data frame or of a computed column, optionally followed by
+// a label, e.g. "name:Workflow". A computed column is either defined by an arithmetic expression on
+// the numeric columns, e.g. "duration_h=duration/3600", or named after a column and a unit to convert
+// it to, e.g. "size_gib" or "duration_h". Aggregations are "count" or functions among sum, avg, min
+// and max applied to a column, e.g. "sum(size)". If there are group-by columns without aggregations,
+// the rows of each group are counted.
+func ParseReport(columns, groupBy, aggregations []string) (Report, error) {
+	var report Report
+	for _, spec := range columns {
+		column, err := parseColumn(spec)
+		if err != nil {
+			return report, err
+		}
+		report.Columns = append(report.Columns, column)
+	}
+	report.GroupBy = groupBy
+	for _, spec := range aggregations {
+		aggregation, err := parseAggregation(spec)
+		if err != nil {
+			return report, err
+		}
+		report.Aggregations = append(report.Aggregations, aggregation)
+	}
+	if len(report.GroupBy) > 0 && len(report.Aggregations) == 0 {
+		report.Aggregations = []Aggregation{{Function: "count"}}
+	}
+	return report, nil
+}
+
+// IsEmpty returns whether the report keeps the data frame as it is.
+func (r Report) IsEmpty() bool {
+	return len(r.Columns) == 0 && !r.Groups()
+}
+
+// Groups returns whether the rows are aggregated, so that all of them are needed to build the report.
+func (r Report) Groups() bool {
+	return len(r.GroupBy) > 0 || len(r.Aggregations) > 0
+}
+
+// References returns the names of the columns of the data frame used in the report. Since computed
+// columns are not known in advance, their names are included as well.
+func (r Report) References() []string {
+	var references []string
+	add := func(name string) {
+		if name != "" && !slices.Contains(references, name) {
+			references = append(references, name)
+		}
+	}
+	for _, column := range r.Columns {
+		add(column.Name)
+		if i := strings.LastIndex(column.Name, "_"); i > 0 && column.expr == nil {
+			// The column may be converted to another unit, e.g. size_gib.
+			add(column.Name[:i])
+		}
+		if column.expr != nil {
+			for _, name := range column.expr.references() {
+				add(name)
+			}
+		}
+	}
+	for _, name := range r.GroupBy {
+		add(name)
+	}
+	for _, aggregation := range r.Aggregations {
+		add(aggregation.Column)
+	}
+	return references
+}
+
+// Apply builds the report from the data frame. The computed columns are added first, so that they can
+// be grouped or aggregated. Then, if the rows are grouped, the report is made of the group-by columns
+// followed by the aggregations, which are renamed according to the labels of the matching columns.
+// Otherwise, it is made of the given columns.
+func (r Report) Apply(df dataframe.DataFrame) (dataframe.DataFrame, error) {
+	if r.IsEmpty() {
+		return df, nil
+	}
+	var err error
+	outputs := slices.Clone(r.GroupBy)
+	for _, aggregation := range r.Aggregations {
+		outputs = append(outputs, aggregation.String())
+	}
+	for _, column := range r.Columns {
+		if column.expr == nil && (slices.Contains(df.Names(), column.Name) ||
+			r.Groups() && slices.Contains(outputs, column.Name)) {
+			// The column is either selected or only renamed once the rows are grouped.
+			continue
+		}
+		if column.expr == nil {
+			column.expr, err = unitConversion(column.Name, df.Names())
+			if err != nil {
+				return df, err
+			}
+		}
+		values, err := column.compute(df)
+		if err != nil {
+			return df, err
+		}
+		df = df.Mutate(values)
+	}
+
+	var names []string
+	if r.Groups() {
+		df, err = r.group(df)
+		if err != nil {
+			return df, err
+		}
+		names = df.Names()
+	} else {
+		for _, column := range r.Columns {
+			names = append(names, column.Name)
+		}
+	}
+
+	var cols []series.Series
+	for _, name := range names {
+		col := df.Col(name).Copy()
+		for _, column := range r.Columns {
+			if column.Name == name && column.Label != "" {
+				col.Name = column.Label
+			}
+		}
+		cols = append(cols, col)
+	}
+	return dataframe.New(cols...), nil
+}
+
+// group aggregates the rows of the data frame by the values of the group-by columns, keeping the groups
+// in the order of their first row.
+func (r Report) group(df dataframe.DataFrame) (dataframe.DataFrame, error) {
+	for _, name := range r.GroupBy {
+		if !slices.Contains(df.Names(), name) {
+			return df, fmt.Errorf("group-by column '%s' does not exist", name)
+		}
+	}
+	for _, aggregation := range r.Aggregations {
+		if aggregation.Column != "" && !slices.Contains(df.Names(), aggregation.Column) {
+			return df, fmt.Errorf("aggregated column '%s' does not exist", aggregation.Column)
+		}
+	}
+
+	var keys []string
+	groups := map[string][]int{}
+	for i := 0; i < df.Nrow(); i++ {
+		var key []string
+		for _, name := range r.GroupBy {
+			key = append(key, df.Col(name).Elem(i).String())
+		}
+		k := strings.Join(key, "\x00")
+		if _, exists := groups[k]; !exists {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], i)
+	}
+	if len(r.GroupBy) == 0 && len(keys) == 0 {
+		// Aggregating all the rows gives a single row, even if there are none.
+		keys = []string{""}
+	}
+
+	var cols []series.Series
+	for _, name := range r.GroupBy {
+		values := make([]string, len(keys))
+		for i, k := range keys {
+			values[i] = df.Col(name).Elem(groups[k][0]).String()
+		}
+		cols = append(cols, series.New(values, series.String, name))
+	}
+	for _, aggregation := range r.Aggregations {
+		values := make([]any, len(keys))
+		for i, k := range keys {
+			values[i] = aggregation.apply(df, groups[k])
+		}
+		cols = append(cols, newNumberSeries(values, aggregation.String()))
+	}
+	return dataframe.New(cols...), nil
+}
+
+// String returns the name of the aggregation, e.g. "sum(size)".
+func (a Aggregation) String() string {
+	if a.Column == "" {
+		return a.Function
+	}
+	return a.Function + "(" + a.Column + ")"
+}
+
+// apply returns the aggregate of the given rows, or nil if there are no values to aggregate.
+func (a Aggregation) apply(df dataframe.DataFrame, rows []int) any {
+	if a.Column == "" {
+		return len(rows)
+	}
+	var values []float64
+	for _, row := range rows {
+		if value, ok := numericValue(df.Col(a.Column).Elem(row)); ok {
+			values = append(values, value)
+		}
+	}
+	if a.Function == "count" {
+		return len(values)
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	result := values[0]
+	for _, value := range values[1:] {
+		switch a.Function {
+		case "sum", "avg":
+			result += value
+		case "min":
+			result = math.Min(result, value)
+		case "max":
+			result = math.Max(result, value)
+		}
+	}
+	if a.Function == "avg" {
+		result /= float64(len(values))
+	}
+	return result
+}
+
+// compute returns the values of a computed column.
+func (c Column) compute(df dataframe.DataFrame) (series.Series, error) {
+	for _, name := range c.expr.references() {
+		if !slices.Contains(df.Names(), name) {
+			return series.Series{}, fmt.Errorf("column '%s' does not exist", name)
+		}
+	}
+	values := make([]any, df.Nrow())
+	for i := range values {
+		value, ok := c.expr.eval(func(name string) (float64, bool) {
+			return numericValue(df.Col(name).Elem(i))
+		})
+		if ok {
+			values[i] = value
+		}
+	}
+	return newNumberSeries(values, c.Name), nil
+}
+
+// parseColumn parses a column such as "name", "name:Workflow", "size_gib" or "duration_h=duration/3600".
+func parseColumn(spec string) (Column, error) {
+	var column Column
+	definition, label, _ := strings.Cut(spec, ":")
+	column.Label = strings.TrimSpace(label)
+	name, expr, computed := strings.Cut(definition, "=")
+	column.Name = strings.TrimSpace(name)
+	if column.Name == "" {
+		return column, fmt.Errorf("invalid column '%s': missing column name", spec)
+	}
+	if computed {
+		var err error
+		column.expr, err = parseArithmetic(expr)
+		if err != nil {
+			return column, fmt.Errorf("invalid column '%s': %s", spec, err.Error())
+		}
+	}
+	return column, nil
+}
+
+// parseAggregation parses an aggregation such as "count" or "sum(size)".
+func parseAggregation(spec string) (Aggregation, error) {
+	spec = strings.TrimSpace(spec)
+	function, column, hasColumn := strings.Cut(spec, "(")
+	aggregation := Aggregation{Function: strings.ToLower(function)}
+	if hasColumn {
+		if !strings.HasSuffix(column, ")") || len(column) == 1 {
+			return aggregation, fmt.Errorf("invalid aggregation '%s': missing column", spec)
+		}
+		aggregation.Column = strings.TrimSuffix(column, ")")
+	}
+	if !slices.Contains(aggregationFunctions, aggregation.Function) {
+		return aggregation, fmt.Errorf(
+			"invalid aggregation '%s': available functions are '%s'",
+			spec,
+			strings.Join(aggregationFunctions, "', '"),
+		)
+	}
+	if aggregation.Column == "" && aggregation.Function != "count" {
+		return aggregation, fmt.Errorf("invalid aggregation '%s': missing column", spec)
+	}
+	return aggregation, nil
+}
+
+// unitConversion returns the expression converting a column to the unit suffixing the given name, such as
+// "size_gib" for the size in GiB or "duration_h" for the duration in hours.
+func unitConversion(name string, columns []string) (arithmetic, error) {
+	i := strings.LastIndex(name, "_")
+	if i > 0 && slices.Contains(columns, name[:i]) {
+		unit := name[i+1:]
+		if bytes, err := datautils.ParseByteSize("1" + unit); err == nil {
+			return binary{'/', reference(name[:i]), number(bytes)}, nil
+		}
+		if duration, err := datautils.ParseDuration("1" + unit); err == nil && duration.Seconds() >= 1 {
+			return binary{'/', reference(name[:i]), number(duration.Seconds())}, nil
+		}
+	}
+	return nil, fmt.Errorf(
+		"column '%s' does not exist\nAvailable columns are '%s'",
+		name,
+		strings.Join(columns, "', '"),
+	)
+}
+
+// numericValue returns the numeric value of an element, parsing strings such as byte sizes if needed.
+func numericValue(elem series.Element) (float64, bool) {
+	if elem.IsNA() {
+		return 0, false
+	}
+	if elem.Type() == series.Int || elem.Type() == series.Float {
+		return elem.Float(), true
+	}
+	str := elem.String()
+	if value, err := strconv.ParseFloat(str, 64); err == nil {
+		return value, true
+	}
+	if bytes, err := datautils.ParseByteSize(str); err == nil {
+		return float64(bytes), true
+	}
+	return 0, false
+}
+
+// newNumberSeries returns a series of numbers rounded to two decimals, which are integers if all of them
+// are. Missing values are nil.
+func newNumberSeries(values []any, name string) series.Series {
+	integers := true
+	for i, value := range values {
+		switch v := value.(type) {
+		case float64:
+			v = math.Round(v*100) / 100
+			values[i] = v
+			integers = integers && v == math.Trunc(v) && math.Abs(v) < math.MaxInt32
+		case int:
+			values[i] = float64(v)
+		}
+	}
+	if !integers {
+		return series.New(values, series.Float, name)
+	}
+	for i, value := range values {
+		if v, ok := value.(float64); ok {
+			values[i] = int(v)
+		}
+	}
+	return series.New(values, series.Int, name)
+}
+
+// arithmetic is an arithmetic expression on the numeric columns of a data frame.
+type arithmetic interface {
+	// eval returns the value of the expression, given the value of each column,
+	// or false if a value is missing.
+	eval(value func(name string) (float64, bool)) (float64, bool)
+	// references returns the names of the columns used in the expression.
+	references() []string
+}
+
+// number is a constant.
+type number float64
+
+// reference is the value of a column.
+type reference string
+
+// binary is an operation on two expressions.
+type binary struct {
+	operator    rune
+	left, right arithmetic
+}
+
+func (n number) eval(func(string) (float64, bool)) (float64, bool) {
+	return float64(n), true
+}
+
+func (n number) references() []string {
+	return nil
+}
+
+func (r reference) eval(value func(string) (float64, bool)) (float64, bool) {
+	return value(string(r))
+}
+
+func (r reference) references() []string {
+	return []string{string(r)}
+}
+
+func (b binary) eval(value func(string) (float64, bool)) (float64, bool) {
+	left, ok := b.left.eval(value)
+	if !ok {
+		return 0, false
+	}
+	right, ok := b.right.eval(value)
+	if !ok {
+		return 0, false
+	}
+	switch b.operator {
+	case '+':
+		return left + right, true
+	case '-':
+		return left - right, true
+	case '*':
+		return left * right, true
+	default:
+		return left / right, right != 0
+	}
+}
+
+func (b binary) references() []string {
+	return append(b.left.references(), b.right.references()...)
+}
+
+// parseArithmetic parses an arithmetic expression made of column names, numbers, the operators
+// '+', '-', '*' and '/', and parentheses.
+func parseArithmetic(expr string) (arithmetic, error) {
+	p := &arithmeticParser{input: []rune(expr)}
+	result, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.pos < len(p.input) {
+		return nil, fmt.Errorf("unexpected '%c'", p.input[p.pos])
+	}
+	return result, nil
+}
+
+// arithmeticParser parses arithmetic expressions by recursive descent.
+type arithmeticParser struct {
+	input []rune
+	pos   int
+}
+
+// parseSum parses terms separated by '+' or '-'.
+func (p *arithmeticParser) parseSum() (arithmetic, error) {
+	return p.parseOperations("+-", p.parseProduct)
+}
+
+// parseProduct parses factors separated by '*' or '/'.
+func (p *arithmeticParser) parseProduct() (arithmetic, error) {
+	return p.parseOperations("*/", p.parseFactor)
+}
+
+// parseOperations parses operands separated by the given operators, from left to right.
+func (p *arithmeticParser) parseOperations(
+	operators string,
+	parseOperand func() (arithmetic, error),
+) (arithmetic, error) {
+	result, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpaces()
+		if p.pos == len(p.input) || !strings.ContainsRune(operators, p.input[p.pos]) {
+			return result, nil
+		}
+		operator := p.input[p.pos]
+		p.pos++
+		operand, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		result = binary{operator, result, operand}
+	}
+}
+
+// parseFactor parses a number, a column name, a negated factor or an expression between parentheses.
+func (p *arithmeticParser) parseFactor() (arithmetic, error) {
+	p.skipSpaces()
+	if p.pos == len(p.input) {
+		return nil, errors.New("unexpected end of expression")
+	}
+	c := p.input[p.pos]
+	switch {
+	case c == '(':
+		p.pos++
+		result, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if p.pos == len(p.input) || p.input[p.pos] != ')' {
+			return nil, errors.New("missing closing parenthesis")
+		}
+		p.pos++
+		return result, nil
+	case c == '-':
+		p.pos++
+		operand, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return binary{'-', number(0), operand}, nil
+	case unicode.IsDigit(c) || c == '.':
+		start := p.pos
+		for p.pos < len(p.input) && (unicode.IsDigit(p.input[p.pos]) || p.input[p.pos] == '.') {
+			p.pos++
+		}
+		value, err := strconv.ParseFloat(string(p.input[start:p.pos]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number '%s'", string(p.input[start:p.pos]))
+		}
+		return number(value), nil
+	case unicode.IsLetter(c) || c == '_':
+		start := p.pos
+		for p.pos < len(p.input) && (unicode.IsLetter(p.input[p.pos]) ||
+			unicode.IsDigit(p.input[p.pos]) || p.input[p.pos] == '_') {
+			p.pos++
+		}
+		return reference(p.input[start:p.pos]), nil
+	default:
+		return nil, fmt.Errorf("unexpected '%c'", c)
+	}
+}
+
+// skipSpaces skips the spaces at the current position.
+func (p *arithmeticParser) skipSpaces() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
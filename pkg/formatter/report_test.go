@@ -0,0 +1,195 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package formatter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+	"golang.org/x/exp/slices"
+)
+
+func TestReportApply(t *testing.T) {
+	df := dataframe.New(
+		series.New([]string{"a", "b", "c", "d"}, series.String, "name"),
+		series.New([]string{"finished", "failed", "finished", "running"}, series.String, "status"),
+		series.New([]int{3600, 1800, 5400, 900}, series.Int, "duration"),
+		series.New([]string{"1 GiB", "512 MiB", "2 GiB", "-"}, series.String, "size"),
+	)
+	tests := map[string]struct {
+		columns      []string
+		groupBy      []string
+		aggregations []string
+		want         [][]string
+	}{
+		"no report": {
+			want: [][]string{
+				{"name", "status", "duration", "size"},
+				{"a", "finished", "3600", "1 GiB"},
+				{"b", "failed", "1800", "512 MiB"},
+				{"c", "finished", "5400", "2 GiB"},
+				{"d", "running", "900", "-"},
+			},
+		},
+		"selected and renamed columns": {
+			columns: []string{"status:State", "name"},
+			want: [][]string{
+				{"State", "name"},
+				{"finished", "a"},
+				{"failed", "b"},
+				{"finished", "c"},
+				{"running", "d"},
+			},
+		},
+		"computed columns": {
+			columns: []string{"name", "duration_h=duration/3600", "size_gib", "rate=(duration+0)/size_gib:Rate"},
+			want: [][]string{
+				{"name", "duration_h", "size_gib", "Rate"},
+				{"a", "1", "1", "3600"},
+				{"b", "0.5", "0.5", "3600"},
+				{"c", "1.5", "2", "2700"},
+				{"d", "0.25", "-", "-"},
+			},
+		},
+		"group by": {
+			groupBy:      []string{"status"},
+			aggregations: []string{"count", "sum(duration)", "avg(size)", "max(duration)"},
+			want: [][]string{
+				{"status", "count", "sum(duration)", "avg(size)", "max(duration)"},
+				{"finished", "2", "9000", "1610612736", "5400"},
+				{"failed", "1", "1800", "536870912", "1800"},
+				{"running", "1", "900", "-", "900"},
+			},
+		},
+		"group by counting by default": {
+			columns: []string{"status:State", "count:Runs"},
+			groupBy: []string{"status"},
+			want: [][]string{
+				{"State", "Runs"},
+				{"finished", "2"},
+				{"failed", "1"},
+				{"running", "1"},
+			},
+		},
+		"group by computed column": {
+			columns:      []string{"duration_h"},
+			groupBy:      []string{"status"},
+			aggregations: []string{"min(duration_h)", "count(size)"},
+			want: [][]string{
+				{"status", "min(duration_h)", "count(size)"},
+				{"finished", "1", "2"},
+				{"failed", "0.5", "1"},
+				{"running", "0.25", "0"},
+			},
+		},
+		"aggregates of all the rows": {
+			aggregations: []string{"count", "sum(size)"},
+			want: [][]string{
+				{"count", "sum(size)"},
+				{"4", "3758096384"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			report, err := ParseReport(test.columns, test.groupBy, test.aggregations)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			got, err := report.Apply(df)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			data := append([][]string{got.Names()}, DataFrameToStringData(got)...)
+			if !reflect.DeepEqual(data, test.want) {
+				t.Errorf("expected %v, got %v", test.want, data)
+			}
+		})
+	}
+}
+
+func TestReportErrors(t *testing.T) {
+	df := dataframe.New(
+		series.New([]string{"a"}, series.String, "name"),
+		series.New([]int{60}, series.Int, "duration"),
+	)
+	tests := map[string]struct {
+		columns      []string
+		groupBy      []string
+		aggregations []string
+		wantError    string
+	}{
+		"unknown column": {
+			columns:   []string{"user"},
+			wantError: "column 'user' does not exist",
+		},
+		"unknown unit": {
+			columns:   []string{"duration_x"},
+			wantError: "column 'duration_x' does not exist",
+		},
+		"invalid expression": {
+			columns:   []string{"d=duration/"},
+			wantError: "invalid column 'd=duration/': unexpected end of expression",
+		},
+		"unknown column in expression": {
+			columns:   []string{"d=runtime*2"},
+			wantError: "column 'runtime' does not exist",
+		},
+		"unknown aggregation": {
+			aggregations: []string{"median(duration)"},
+			wantError:    "invalid aggregation 'median(duration)'",
+		},
+		"aggregation without column": {
+			aggregations: []string{"sum"},
+			wantError:    "invalid aggregation 'sum': missing column",
+		},
+		"unknown group-by column": {
+			groupBy:   []string{"status"},
+			wantError: "group-by column 'status' does not exist",
+		},
+		"unknown aggregated column": {
+			aggregations: []string{"sum(size)"},
+			wantError:    "aggregated column 'size' does not exist",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			report, err := ParseReport(test.columns, test.groupBy, test.aggregations)
+			if err == nil {
+				_, err = report.Apply(df)
+			}
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if !strings.HasPrefix(err.Error(), test.wantError) {
+				t.Errorf("expected error '%s', got '%s'", test.wantError, err.Error())
+			}
+		})
+	}
+}
+
+func TestReportReferences(t *testing.T) {
+	report, err := ParseReport(
+		[]string{"name:Workflow", "size_gib", "hours=duration/3600"},
+		[]string{"status"},
+		[]string{"sum(size)"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	want := []string{"name", "size_gib", "size", "hours", "duration", "status"}
+	if got := report.References(); !slices.Equal(got, want) {
+		t.Errorf("expected references %v, got %v", want, got)
+	}
+}
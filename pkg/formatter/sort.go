@@ -0,0 +1,190 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package formatter
+
+import (
+	"fmt"
+	"reanahub/reana-client-go/pkg/datautils"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+// runNumberColumn column whose values are compared as semantic run numbers, e.g. 2.10 after 2.9.
+const runNumberColumn = "run_number"
+
+// SortKey is a column to sort a dataFrame by, in ascending or descending order.
+type SortKey struct {
+	Column     string
+	Descending bool
+}
+
+// ParseSortKeys parses a list of sort columns such as "status", "-created" or "+run_number".
+// Columns prefixed with "-" are sorted in descending order, the others in ascending order.
+func ParseSortKeys(columns []string) ([]SortKey, error) {
+	var keys []SortKey
+	for _, column := range columns {
+		key := SortKey{Column: strings.ToLower(strings.TrimSpace(column))}
+		if strings.HasPrefix(key.Column, "-") {
+			key.Column = key.Column[1:]
+			key.Descending = true
+		} else {
+			key.Column = strings.TrimPrefix(key.Column, "+")
+		}
+		if key.Column == "" {
+			return nil, fmt.Errorf("invalid sort column '%s'", column)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// SortDataFrame sorts the given dataFrame by the given keys, the first key having the highest priority.
+// Values are compared according to their type: numbers, dates, byte sizes (e.g. "1.5 GiB"),
+// durations (e.g. "1h30m") and run numbers (e.g. "2.10" after "2.9") are recognised.
+// Empty values are always placed last. The order of rows with equal values is preserved.
+func SortDataFrame(df dataframe.DataFrame, keys []SortKey) (dataframe.DataFrame, error) {
+	if len(keys) == 0 || df.Nrow() == 0 {
+		return df, nil
+	}
+
+	columns := make([]sortColumn, len(keys))
+	for i, key := range keys {
+		name := ""
+		for _, columnName := range df.Names() {
+			if strings.EqualFold(columnName, key.Column) {
+				name = columnName
+				break
+			}
+		}
+		if name == "" {
+			return df, fmt.Errorf("column '%s' does not exist", key.Column)
+		}
+		columns[i] = newSortColumn(df.Col(name))
+	}
+
+	indexes := make([]int, df.Nrow())
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		a, b := indexes[i], indexes[j]
+		for k, column := range columns {
+			if column.na[a] || column.na[b] {
+				if column.na[a] == column.na[b] {
+					continue
+				}
+				return column.na[b]
+			}
+			cmp := column.compare(a, b)
+			if cmp == 0 {
+				continue
+			}
+			if keys[k].Descending {
+				return cmp > 0
+			}
+			return cmp < 0
+		}
+		return false
+	})
+	return df.Subset(indexes), nil
+}
+
+// sortColumn holds the values of a column in a form that can be compared.
+type sortColumn struct {
+	na       []bool
+	records  []string
+	numbers  []float64
+	versions bool
+}
+
+// newSortColumn detects the type of the values of the given series and converts them to be compared.
+func newSortColumn(s series.Series) sortColumn {
+	column := sortColumn{
+		na:      make([]bool, s.Len()),
+		records: s.Records(),
+	}
+	for i, record := range column.records {
+		column.na[i] = s.Elem(i).IsNA() || record == "" || record == "-"
+	}
+
+	if s.Type() == series.Int || s.Type() == series.Float {
+		column.numbers = s.Float()
+		return column
+	}
+	if s.Name == runNumberColumn {
+		column.versions = true
+		return column
+	}
+
+	parsers := []func(string) (float64, error){
+		func(value string) (float64, error) {
+			return strconv.ParseFloat(value, 64)
+		},
+		func(value string) (float64, error) {
+			date, err := datautils.ParseDate(value)
+			return float64(date.UnixMicro()), err
+		},
+		func(value string) (float64, error) {
+			size, err := datautils.ParseByteSize(value)
+			return float64(size), err
+		},
+		func(value string) (float64, error) {
+			duration, err := datautils.ParseDuration(value)
+			return float64(duration), err
+		},
+	}
+	for _, parse := range parsers {
+		if numbers, ok := column.parseNumbers(parse); ok {
+			column.numbers = numbers
+			break
+		}
+	}
+	return column
+}
+
+// parseNumbers converts all the non empty values of the column with the given parser.
+// Returns false if there are no such values or if any of them cannot be parsed.
+func (c sortColumn) parseNumbers(parse func(string) (float64, error)) ([]float64, bool) {
+	numbers := make([]float64, len(c.records))
+	parsed := false
+	for i, record := range c.records {
+		if c.na[i] {
+			continue
+		}
+		number, err := parse(record)
+		if err != nil {
+			return nil, false
+		}
+		numbers[i] = number
+		parsed = true
+	}
+	return numbers, parsed
+}
+
+// compare returns -1, 0 or 1 if the value of row a is smaller than, equal to or greater than the one of row b.
+func (c sortColumn) compare(a, b int) int {
+	switch {
+	case c.numbers != nil:
+		if c.numbers[a] == c.numbers[b] {
+			return 0
+		}
+		if c.numbers[a] < c.numbers[b] {
+			return -1
+		}
+		return 1
+	case c.versions:
+		return datautils.CompareVersions(c.records[a], c.records[b])
+	default:
+		return strings.Compare(c.records[a], c.records[b])
+	}
+}
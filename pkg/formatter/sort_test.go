@@ -0,0 +1,213 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022, 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package formatter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+func TestParseSortKeys(t *testing.T) {
+	tests := map[string]struct {
+		columns   []string
+		expected  []SortKey
+		wantError bool
+	}{
+		"no columns": {},
+		"ascending and descending": {
+			columns: []string{"status", "-CREATED", "+run_number"},
+			expected: []SortKey{
+				{Column: "status"},
+				{Column: "created", Descending: true},
+				{Column: "run_number"},
+			},
+		},
+		"empty column": {
+			columns:   []string{"status", "-"},
+			wantError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			keys, err := ParseSortKeys(test.columns)
+			if test.wantError {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if !reflect.DeepEqual(keys, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, keys)
+			}
+		})
+	}
+}
+
+func TestSortDataFrame(t *testing.T) {
+	tests := map[string]struct {
+		df        dataframe.DataFrame
+		keys      []SortKey
+		column    string
+		expected  []string
+		wantError bool
+	}{
+		"sort ascending": {
+			df: dataframe.New(
+				series.New([]string{"b", "a", "c"}, series.String, "col1"),
+			),
+			keys:     []SortKey{{Column: "col1"}},
+			column:   "col1",
+			expected: []string{"a", "b", "c"},
+		},
+		"sort descending": {
+			df: dataframe.New(
+				series.New([]string{"b", "a", "c"}, series.String, "col1"),
+			),
+			keys:     []SortKey{{Column: "col1", Descending: true}},
+			column:   "col1",
+			expected: []string{"c", "b", "a"},
+		},
+		"sort int": {
+			df: dataframe.New(
+				series.New([]string{"b", "a", "c"}, series.String, "col1"),
+				series.New([]int{2, 1, 3}, series.Int, "col2"),
+			),
+			keys:     []SortKey{{Column: "col2"}},
+			column:   "col2",
+			expected: []string{"1", "2", "3"},
+		},
+		"sort float": {
+			df: dataframe.New(
+				series.New([]float64{2.5, 10.0, 3.0}, series.Float, "col1"),
+			),
+			keys:     []SortKey{{Column: "col1"}},
+			column:   "col1",
+			expected: []string{"2.500000", "3.000000", "10.000000"},
+		},
+		"sort numeric strings": {
+			df: dataframe.New(
+				series.New([]string{"9", "10", "100"}, series.String, "col1"),
+			),
+			keys:     []SortKey{{Column: "col1"}},
+			column:   "col1",
+			expected: []string{"9", "10", "100"},
+		},
+		"sort run_numbers": {
+			df: dataframe.New(
+				series.New(
+					[]string{"1", "2.2", "10", "9.1", "1.15", "2.10", "1.1200"},
+					series.String,
+					"run_number",
+				),
+			),
+			keys:     []SortKey{{Column: "run_number"}},
+			column:   "run_number",
+			expected: []string{"1", "1.15", "1.1200", "2.2", "2.10", "9.1", "10"},
+		},
+		"sort size human_readable": {
+			df: dataframe.New(
+				series.New(
+					[]string{"255 KiB", "1.92 MiB", "192 KiB", "1.1 GiB", "1 GB"},
+					series.String,
+					"size",
+				),
+			),
+			keys:     []SortKey{{Column: "size"}},
+			column:   "size",
+			expected: []string{"192 KiB", "255 KiB", "1.92 MiB", "1 GB", "1.1 GiB"},
+		},
+		"sort dates": {
+			df: dataframe.New(
+				series.New(
+					[]string{"2022-06-01T09:30:00", "2022-10-07T16:35:29", "2022-06-01"},
+					series.String,
+					"created",
+				),
+			),
+			keys:     []SortKey{{Column: "created", Descending: true}},
+			column:   "created",
+			expected: []string{"2022-10-07T16:35:29", "2022-06-01T09:30:00", "2022-06-01"},
+		},
+		"sort durations": {
+			df: dataframe.New(
+				series.New([]string{"1h", "90s", "2d", "45m"}, series.String, "duration"),
+			),
+			keys:     []SortKey{{Column: "duration"}},
+			column:   "duration",
+			expected: []string{"90s", "45m", "1h", "2d"},
+		},
+		"empty values last": {
+			df: dataframe.New(
+				series.New([]string{"-", "2 KiB", "", "1 KiB"}, series.String, "size"),
+			),
+			keys:     []SortKey{{Column: "size", Descending: true}},
+			column:   "size",
+			expected: []string{"2 KiB", "1 KiB", "-", ""},
+		},
+		"multiple keys": {
+			df: dataframe.New(
+				series.New(
+					[]string{"wf.1", "wf.2", "wf.3", "wf.4", "wf.5"},
+					series.String,
+					"name",
+				),
+				series.New(
+					[]string{"running", "finished", "finished", "running", "finished"},
+					series.String,
+					"status",
+				),
+				series.New(
+					[]string{"2022-01-01", "2022-01-02", "2022-01-03", "2022-01-03", "2022-01-02"},
+					series.String,
+					"created",
+				),
+				series.New([]string{"1", "2", "3", "4", "10"}, series.String, "run_number"),
+			),
+			keys: []SortKey{
+				{Column: "status"},
+				{Column: "created", Descending: true},
+				{Column: "run_number"},
+			},
+			column:   "name",
+			expected: []string{"wf.3", "wf.2", "wf.5", "wf.4", "wf.1"},
+		},
+		"non-existent column": {
+			df: dataframe.New(
+				series.New([]string{"b", "a", "c"}, series.String, "col1"),
+			),
+			keys:      []SortKey{{Column: "col1"}, {Column: "invalid"}},
+			wantError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			df, err := SortDataFrame(test.df, test.keys)
+			if test.wantError {
+				if err == nil {
+					t.Errorf("Expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got '%s'", err.Error())
+			}
+			if got := df.Col(test.column).Records(); !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+// Package glob matches workspace paths against glob patterns.
+//
+// The following syntax is supported:
+//
+//	'*'      any sequence of characters, except '/'
+//	'**'     any sequence of characters, including '/'; "**/" also matches no directory at all
+//	'?'      any single character, except '/'
+//	'[abc]'  any character of the class, which can contain ranges such as [a-z] and be negated with [!a]
+//	'{a,b}'  any of the comma-separated alternatives, which can themselves contain patterns
+//	'\x'     the character x, even if it is a special one
+//
+// A pattern selects a path if it matches the path itself or one of its parent directories,
+// so that "data" or "data/*" select all the files below the data directory.
+package glob
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Pattern is a compiled glob pattern.
+type Pattern struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+// Compile compiles a glob pattern. Leading "./" and "/", as well as trailing "/", are ignored.
+func Compile(pattern string) (*Pattern, error) {
+	expr, err := translate(Clean(pattern))
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern '%s': %s", pattern, err.Error())
+	}
+	re, err := regexp.Compile("^" + expr + "$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern '%s': %s", pattern, err.Error())
+	}
+	return &Pattern{pattern: pattern, re: re}, nil
+}
+
+// CompileAll compiles several glob patterns.
+func CompileAll(patterns []string) ([]*Pattern, error) {
+	compiled := make([]*Pattern, 0, len(patterns))
+	for _, pattern := range patterns {
+		p, err := Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, p)
+	}
+	return compiled, nil
+}
+
+// String returns the pattern as it was given.
+func (p *Pattern) String() string {
+	return p.pattern
+}
+
+// Match returns whether the pattern matches the whole path.
+func (p *Pattern) Match(path string) bool {
+	return p.re.MatchString(Clean(path))
+}
+
+// Select returns whether the pattern matches the path or one of its parent directories.
+func (p *Pattern) Select(path string) bool {
+	path = Clean(path)
+	for {
+		if p.re.MatchString(path) {
+			return true
+		}
+		i := strings.LastIndex(path, "/")
+		if i < 0 {
+			return false
+		}
+		path = path[:i]
+	}
+}
+
+// SelectAny returns whether any of the patterns selects the path, see Pattern.Select.
+func SelectAny(patterns []*Pattern, path string) bool {
+	for _, p := range patterns {
+		if p.Select(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasMeta returns whether the pattern contains special characters, i.e. whether it is not a plain path.
+func HasMeta(pattern string) bool {
+	return strings.ContainsAny(pattern, `*?[{\`)
+}
+
+// HasExtendedMeta returns whether the pattern uses syntax that shell globs do not support, i.e. '**',
+// braces or escapes, so that it cannot be resolved by the server.
+func HasExtendedMeta(pattern string) bool {
+	return strings.Contains(pattern, "**") || strings.ContainsAny(pattern, `{\`)
+}
+
+// Clean removes the leading "./" and "/" and the trailing "/" of a workspace path.
+func Clean(path string) string {
+	for {
+		trimmed := strings.TrimPrefix(strings.TrimPrefix(path, "./"), "/")
+		if trimmed == path {
+			break
+		}
+		path = trimmed
+	}
+	if path != "/" {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return path
+}
+
+// translate translates a glob pattern into a regular expression.
+func translate(pattern string) (string, error) {
+	var expr strings.Builder
+	braces := 0
+	chars := []rune(pattern)
+	for i := 0; i < len(chars); i++ {
+		c := chars[i]
+		switch {
+		case c == '\\':
+			if i+1 == len(chars) {
+				return "", errors.New("trailing backslash")
+			}
+			i++
+			expr.WriteString(regexp.QuoteMeta(string(chars[i])))
+		case c == '*':
+			stars := 1
+			for i+1 < len(chars) && chars[i+1] == '*' {
+				stars++
+				i++
+			}
+			switch {
+			case stars == 1:
+				expr.WriteString("[^/]*")
+			case i+1 < len(chars) && chars[i+1] == '/' && (i+1-stars == 0 || chars[i-stars] == '/'):
+				// "**/" matches any number of directories, including none.
+				expr.WriteString("(?:.*/)?")
+				i++
+			default:
+				expr.WriteString(".*")
+			}
+		case c == '?':
+			expr.WriteString("[^/]")
+		case c == '[':
+			end := classEnd(chars, i)
+			if end < 0 {
+				return "", errors.New("unterminated character class")
+			}
+			expr.WriteString(translateClass(chars[i+1 : end]))
+			i = end
+		case c == '{':
+			braces++
+			expr.WriteString("(?:")
+		case c == ',' && braces > 0:
+			expr.WriteString("|")
+		case c == '}' && braces > 0:
+			braces--
+			expr.WriteString(")")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	if braces > 0 {
+		return "", errors.New("unterminated braces")
+	}
+	return expr.String(), nil
+}
+
+// classEnd returns the index of the bracket closing the character class starting at start, or -1.
+// A closing bracket right after the opening one, or after its negation, is part of the class.
+func classEnd(chars []rune, start int) int {
+	i := start + 1
+	if i < len(chars) && (chars[i] == '!' || chars[i] == '^') {
+		i++
+	}
+	if i < len(chars) && chars[i] == ']' {
+		i++
+	}
+	for ; i < len(chars); i++ {
+		if chars[i] == ']' {
+			return i
+		}
+	}
+	return -1
+}
+
+// translateClass translates the content of a character class into a regular expression.
+func translateClass(class []rune) string {
+	var expr strings.Builder
+	expr.WriteString("[")
+	if len(class) > 0 && (class[0] == '!' || class[0] == '^') {
+		expr.WriteString("^/")
+		class = class[1:]
+	}
+	for _, c := range class {
+		if c == '-' {
+			expr.WriteRune(c)
+		} else {
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	expr.WriteString("]")
+	return expr.String()
+}
@@ -0,0 +1,168 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package glob
+
+import (
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	tests := map[string]struct {
+		pattern string
+		matches []string
+		others  []string
+	}{
+		"plain path": {
+			pattern: "data/file.txt",
+			matches: []string{"data/file.txt", "./data/file.txt", "/data/file.txt"},
+			others:  []string{"data/file.txt2", "data"},
+		},
+		"star": {
+			pattern: "data/*.root",
+			matches: []string{"data/a.root", "data/.root"},
+			others:  []string{"data/sub/a.root", "data/a.root.txt"},
+		},
+		"double star": {
+			pattern: "**/*.root",
+			matches: []string{"a.root", "data/a.root", "data/sub/a.root"},
+			others:  []string{"data/a.txt"},
+		},
+		"double star in the middle": {
+			pattern: "data/**/plot.png",
+			matches: []string{"data/plot.png", "data/a/b/plot.png"},
+			others:  []string{"dataplot.png", "other/plot.png"},
+		},
+		"double star at the end": {
+			pattern: "data/**",
+			matches: []string{"data/a", "data/a/b"},
+			others:  []string{"other/a"},
+		},
+		"question mark": {
+			pattern: "run?.log",
+			matches: []string{"run1.log", "runA.log"},
+			others:  []string{"run10.log", "run/.log"},
+		},
+		"character class": {
+			pattern: "run[0-2].log",
+			matches: []string{"run0.log", "run2.log"},
+			others:  []string{"run3.log"},
+		},
+		"negated character class": {
+			pattern: "run[!0-2].log",
+			matches: []string{"run3.log"},
+			others:  []string{"run1.log", "run/.log"},
+		},
+		"braces": {
+			pattern: "plots/*.{png,pdf}",
+			matches: []string{"plots/a.png", "plots/b.pdf"},
+			others:  []string{"plots/c.svg"},
+		},
+		"nested braces": {
+			pattern: "{code/*.{C,py},reana.yaml}",
+			matches: []string{"code/a.C", "code/b.py", "reana.yaml"},
+			others:  []string{"code/c.sh", "reana.yml"},
+		},
+		"escaped characters": {
+			pattern: `data/\*.txt`,
+			matches: []string{"data/*.txt"},
+			others:  []string{"data/a.txt"},
+		},
+		"special regular expression characters": {
+			pattern: "a+b(1).txt",
+			matches: []string{"a+b(1).txt"},
+			others:  []string{"aab1.txt"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := Compile(test.pattern)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			for _, path := range test.matches {
+				if !p.Match(path) {
+					t.Errorf("expected %s to match %s", test.pattern, path)
+				}
+			}
+			for _, path := range test.others {
+				if p.Match(path) {
+					t.Errorf("expected %s not to match %s", test.pattern, path)
+				}
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := map[string]string{
+		"unterminated class":  "data/[a-z.txt",
+		"unterminated braces": "data/{a,b.txt",
+		"trailing backslash":  `data\`,
+	}
+	for name, pattern := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Compile(pattern); err == nil {
+				t.Errorf("expected an error for pattern %s", pattern)
+			}
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	patterns, err := CompileAll([]string{"data", "results/*"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	tests := map[string]bool{
+		"data/a.txt":             true,
+		"/data/sub/b.txt":        true,
+		"results/plot.png":       true,
+		"results/plots/plot.png": true,
+		"database.txt":           false,
+		"code/data":              false,
+	}
+	for path, want := range tests {
+		if got := SelectAny(patterns, path); got != want {
+			t.Errorf("expected selection of %s to be %t, got %t", path, want, got)
+		}
+	}
+}
+
+func TestHasExtendedMeta(t *testing.T) {
+	tests := map[string]bool{
+		"data/file.txt": false,
+		"data/*.txt":    false,
+		"run?.log":      false,
+		"[ab]":          false,
+		"**/*.txt":      true,
+		"{a,b}":         true,
+		`data\*`:        true,
+	}
+	for pattern, want := range tests {
+		if got := HasExtendedMeta(pattern); got != want {
+			t.Errorf("expected HasExtendedMeta(%s) to be %t, got %t", pattern, want, got)
+		}
+	}
+}
+
+func TestHasMeta(t *testing.T) {
+	tests := map[string]bool{
+		"data/file.txt": false,
+		"data/*.txt":    true,
+		"run?.log":      true,
+		"{a,b}":         true,
+		"[ab]":          true,
+	}
+	for pattern, want := range tests {
+		if got := HasMeta(pattern); got != want {
+			t.Errorf("expected HasMeta(%s) to be %t, got %t", pattern, want, got)
+		}
+	}
+}
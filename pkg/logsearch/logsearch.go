@@ -0,0 +1,112 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+// Package logsearch searches logs for lines matching a regular expression, similarly to grep.
+package logsearch
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Line is a line of a log, either matching the pattern or surrounding a matching line.
+type Line struct {
+	Number int    `json:"line"`
+	Text   string `json:"text"`
+	Match  bool   `json:"match"`
+}
+
+// Block is a group of consecutive lines containing one or more matching lines and their context.
+type Block []Line
+
+// Compile compiles the pattern, ignoring the case of letters if requested.
+func Compile(pattern string, ignoreCase bool) (*regexp.Regexp, error) {
+	if ignoreCase {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression '%s': %s", pattern, err.Error())
+	}
+	return re, nil
+}
+
+// Search returns the lines of content matching re, each surrounded by up to context lines before and after.
+// Overlapping or adjacent contexts are merged into a single block. Line numbers start at 1.
+func Search(content string, re *regexp.Regexp, context int) []Block {
+	if content == "" {
+		return nil
+	}
+	if context < 0 {
+		context = 0
+	}
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+
+	var blocks []Block
+	var current Block
+	// last is the index of the last line added to the current block.
+	last := -1
+	for i, line := range lines {
+		if !re.MatchString(line) {
+			continue
+		}
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+		if current != nil && start > last+1 {
+			blocks = append(blocks, current)
+			current = nil
+		}
+		if start <= last {
+			start = last + 1
+		}
+		for j := start; j < i; j++ {
+			current = append(current, Line{Number: j + 1, Text: lines[j]})
+		}
+		if last >= i {
+			// The line was already added as the context of a previous match.
+			current[len(current)-(last-i)-1].Match = true
+		} else {
+			current = append(current, Line{Number: i + 1, Text: line, Match: true})
+			last = i
+		}
+
+		end := i + context
+		if end >= len(lines) {
+			end = len(lines) - 1
+		}
+		for j := last + 1; j <= end; j++ {
+			current = append(current, Line{Number: j + 1, Text: lines[j]})
+			last = j
+		}
+	}
+	if current != nil {
+		blocks = append(blocks, current)
+	}
+	return blocks
+}
+
+// CountMatches returns the number of matching lines in the blocks.
+func CountMatches(blocks []Block) int {
+	count := 0
+	for _, block := range blocks {
+		for _, line := range block {
+			if line.Match {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// Highlight returns the text with every match of re transformed by the highlight function.
+func Highlight(text string, re *regexp.Regexp, highlight func(string) string) string {
+	return re.ReplaceAllStringFunc(text, highlight)
+}
@@ -0,0 +1,117 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package logsearch
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCompile(t *testing.T) {
+	re, err := Compile("error", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !re.MatchString("ROOT Error in TFile") {
+		t.Errorf("expected case insensitive pattern to match")
+	}
+
+	_, err = Compile("error(", false)
+	if err == nil || !strings.Contains(err.Error(), "invalid regular expression 'error('") {
+		t.Errorf("expected invalid regular expression error, got %v", err)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	content := "one\ntwo error\nthree\nfour\nfive\nsix error\nseven error\neight\n"
+	tests := map[string]struct {
+		pattern string
+		context int
+		want    []Block
+	}{
+		"no match": {
+			pattern: "warning",
+			want:    nil,
+		},
+		"without context": {
+			pattern: "error",
+			want: []Block{
+				{{Number: 2, Text: "two error", Match: true}},
+				{
+					{Number: 6, Text: "six error", Match: true},
+					{Number: 7, Text: "seven error", Match: true},
+				},
+			},
+		},
+		"with context": {
+			pattern: "two|seven",
+			context: 1,
+			want: []Block{
+				{
+					{Number: 1, Text: "one"},
+					{Number: 2, Text: "two error", Match: true},
+					{Number: 3, Text: "three"},
+				},
+				{
+					{Number: 6, Text: "six error"},
+					{Number: 7, Text: "seven error", Match: true},
+					{Number: 8, Text: "eight"},
+				},
+			},
+		},
+		"merged contexts": {
+			pattern: "error",
+			context: 2,
+			want: []Block{
+				{
+					{Number: 1, Text: "one"},
+					{Number: 2, Text: "two error", Match: true},
+					{Number: 3, Text: "three"},
+					{Number: 4, Text: "four"},
+					{Number: 5, Text: "five"},
+					{Number: 6, Text: "six error", Match: true},
+					{Number: 7, Text: "seven error", Match: true},
+					{Number: 8, Text: "eight"},
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			re, err := Compile(test.pattern, false)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			got := Search(content, re, test.context)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestCountMatches(t *testing.T) {
+	re, _ := Compile("error", false)
+	blocks := Search("error\nok\nerror\n", re, 1)
+	if got := CountMatches(blocks); got != 2 {
+		t.Errorf("expected 2 matches, got %d", got)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	re, _ := Compile("err[a-z]*", false)
+	got := Highlight("an error and errors", re, func(s string) string {
+		return "[" + s + "]"
+	})
+	if got != "an [error] and [errors]" {
+		t.Errorf("unexpected highlighted text: %s", got)
+	}
+}
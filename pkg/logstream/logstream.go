@@ -0,0 +1,102 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+// Package logstream follows logs that are retrieved repeatedly in full, returning only their new lines.
+package logstream
+
+import (
+	"strings"
+	"time"
+)
+
+// MinOverlap is the minimum number of bytes that the end of the previous content and the start of the new one
+// must have in common for the new content to be considered a continuation of a log whose beginning was truncated.
+const MinOverlap = 32
+
+// Stream tracks the successive contents of a log, to return only what was added since the previous update.
+type Stream struct {
+	previous string
+	partial  string
+}
+
+// Update returns the complete lines added to the log since the previous update, given its whole content.
+// The last line is kept until it is terminated by a newline or the stream is flushed.
+// If the new content is not a continuation of the previous one, e.g. because the log was rotated,
+// the whole new content is returned and reset is true.
+func (s *Stream) Update(content string) (lines []string, reset bool) {
+	added, reset := Diff(s.previous, content)
+	s.previous = content
+	if reset && s.partial != "" {
+		lines = append(lines, s.partial)
+		s.partial = ""
+	}
+
+	parts := strings.Split(s.partial+added, "\n")
+	s.partial = parts[len(parts)-1]
+	return append(lines, parts[:len(parts)-1]...), reset
+}
+
+// Flush returns the last line of the log if it was not terminated by a newline, or an empty string.
+func (s *Stream) Flush() string {
+	partial := s.partial
+	s.partial = ""
+	return partial
+}
+
+// Diff returns the part of content that was added after previous.
+// Logs that were truncated at the beginning are handled by looking for the longest end of previous
+// that content starts with. If there is no such overlap, content is returned as a whole and reset is true.
+func Diff(previous, content string) (added string, reset bool) {
+	if strings.HasPrefix(content, previous) {
+		return content[len(previous):], false
+	}
+	if len(content) >= MinOverlap && len(previous) >= MinOverlap {
+		probe := content[:MinOverlap]
+		for offset := 0; offset <= len(previous)-MinOverlap; {
+			index := strings.Index(previous[offset:], probe)
+			if index < 0 {
+				break
+			}
+			start := offset + index
+			if strings.HasPrefix(content, previous[start:]) {
+				return content[len(previous)-start:], false
+			}
+			offset = start + 1
+		}
+	}
+	return content, true
+}
+
+// Interval is a polling interval that grows while there is no activity, and goes back to its minimum otherwise.
+type Interval struct {
+	min     time.Duration
+	max     time.Duration
+	current time.Duration
+}
+
+// NewInterval creates an interval varying between min and max.
+func NewInterval(min, max time.Duration) *Interval {
+	if max < min {
+		max = min
+	}
+	return &Interval{min: min, max: max}
+}
+
+// Next returns the time to wait before the next poll. The interval is reset to its minimum
+// if there was activity since the previous poll, otherwise it is doubled up to its maximum.
+func (i *Interval) Next(activity bool) time.Duration {
+	if activity || i.current == 0 {
+		i.current = i.min
+	} else {
+		i.current *= 2
+		if i.current > i.max {
+			i.current = i.max
+		}
+	}
+	return i.current
+}
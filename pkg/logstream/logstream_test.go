@@ -0,0 +1,120 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package logstream
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDiff(t *testing.T) {
+	longLine := strings.Repeat("x", MinOverlap)
+	tests := map[string]struct {
+		previous  string
+		content   string
+		wantAdded string
+		wantReset bool
+	}{
+		"first content": {
+			content:   "line 1\n",
+			wantAdded: "line 1\n",
+		},
+		"appended": {
+			previous:  "line 1\n",
+			content:   "line 1\nline 2\n",
+			wantAdded: "line 2\n",
+		},
+		"unchanged": {
+			previous: "line 1\n",
+			content:  "line 1\n",
+		},
+		"truncated at the beginning": {
+			previous:  "line 1\n" + longLine + "\n",
+			content:   longLine + "\nline 3\n",
+			wantAdded: "line 3\n",
+		},
+		"truncated with repeated content": {
+			previous:  longLine + "a" + longLine + "b\n",
+			content:   longLine + "b\nline 3\n",
+			wantAdded: "line 3\n",
+		},
+		"rotated": {
+			previous:  "line 1\nline 2\n",
+			content:   "line 3\n",
+			wantAdded: "line 3\n",
+			wantReset: true,
+		},
+		"overlap too short": {
+			previous:  "line 1\nline 2\n",
+			content:   "line 2\nline 3\n",
+			wantAdded: "line 2\nline 3\n",
+			wantReset: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			added, reset := Diff(test.previous, test.content)
+			if added != test.wantAdded {
+				t.Errorf("expected added content %q, got %q", test.wantAdded, added)
+			}
+			if reset != test.wantReset {
+				t.Errorf("expected reset %t, got %t", test.wantReset, reset)
+			}
+		})
+	}
+}
+
+func TestStream(t *testing.T) {
+	var s Stream
+	updates := []struct {
+		content   string
+		wantLines []string
+		wantReset bool
+	}{
+		{content: "line 1\nline", wantLines: []string{"line 1"}},
+		{content: "line 1\nline 2\n", wantLines: []string{"line 2"}},
+		{content: "line 1\nline 2\nline 3\n", wantLines: []string{"line 3"}},
+		{content: "line 1\nline 2\nline 3\nlast"},
+		{content: "new\n", wantLines: []string{"last", "new"}, wantReset: true},
+		{content: "new\nend"},
+	}
+	for i, update := range updates {
+		lines, reset := s.Update(update.content)
+		if !reflect.DeepEqual(lines, update.wantLines) &&
+			(len(lines) != 0 || len(update.wantLines) != 0) {
+			t.Errorf("update %d: expected lines %v, got %v", i, update.wantLines, lines)
+		}
+		if reset != update.wantReset {
+			t.Errorf("update %d: expected reset %t, got %t", i, update.wantReset, reset)
+		}
+	}
+	if partial := s.Flush(); partial != "end" {
+		t.Errorf("expected flushed line 'end', got %q", partial)
+	}
+	if partial := s.Flush(); partial != "" {
+		t.Errorf("expected nothing to flush, got %q", partial)
+	}
+}
+
+func TestInterval(t *testing.T) {
+	interval := NewInterval(time.Second, 5*time.Second)
+	activities := []bool{false, false, false, false, true, false}
+	want := []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second,
+		time.Second, 2 * time.Second,
+	}
+	for i, activity := range activities {
+		if got := interval.Next(activity); got != want[i] {
+			t.Errorf("poll %d: expected %s, got %s", i, want[i], got)
+		}
+	}
+}
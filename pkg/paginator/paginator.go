@@ -0,0 +1,120 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+// Package paginator walks lazily through the pages of paginated server responses.
+package paginator
+
+// Options defines which pages are retrieved and how many items are kept.
+type Options struct {
+	// Page is the number of the first page to retrieve, starting from 1.
+	Page int64
+	// Size is the number of items per page. If it is 0, the page size is not sent to the server,
+	// which then returns all the items at once, so that there is a single page.
+	Size int64
+	// Limit is the maximum number of items to return, or 0 to return all of them.
+	Limit int64
+	// AllPages is true to retrieve the pages following the first one, false to retrieve only the first one.
+	AllPages bool
+}
+
+// FetchFunc retrieves the items of the page of the given number and size, where a size of 0 means
+// that the page size must not be sent. It also returns the total number of items, or 0 if it is unknown.
+type FetchFunc[T any] func(page, size int64) (items []T, total int64, err error)
+
+// Paginator retrieves the pages of items one at a time, only when they are requested.
+type Paginator[T any] struct {
+	options Options
+	fetch   FetchFunc[T]
+	keep    func(T) bool
+	page    int64
+	count   int64
+	// received is the number of items received from the server, including those beyond the limit.
+	received int64
+	done     bool
+}
+
+// New creates a paginator retrieving pages with fetch according to the options.
+func New[T any](options Options, fetch FetchFunc[T]) *Paginator[T] {
+	if options.Page < 1 {
+		options.Page = 1
+	}
+	return &Paginator[T]{options: options, fetch: fetch, page: options.Page}
+}
+
+// Filter makes the paginator return only the items for which keep returns true, e.g. to filter items
+// locally when the server cannot. The limit applies to the kept items.
+func (p *Paginator[T]) Filter(keep func(T) bool) *Paginator[T] {
+	p.keep = keep
+	return p
+}
+
+// NextPage retrieves the items of the next page, truncated so that no more than the limit are returned
+// in total. It returns false once there are no more pages; the first page is always returned, even if
+// it is empty. The last page is detected from the total number of items if the server returns it, or
+// otherwise when a page is not full. An empty page is always the last one.
+func (p *Paginator[T]) NextPage() ([]T, bool, error) {
+	if p.done {
+		return nil, false, nil
+	}
+	items, total, err := p.fetch(p.page, p.options.Size)
+	if err != nil {
+		p.done = true
+		return nil, false, err
+	}
+
+	received := len(items)
+	p.received += int64(received)
+	if p.keep != nil {
+		var kept []T
+		for _, item := range items {
+			if p.keep(item) {
+				kept = append(kept, item)
+			}
+		}
+		items = kept
+	}
+	if p.options.Limit > 0 && p.count+int64(len(items)) >= p.options.Limit {
+		items = items[:p.options.Limit-p.count]
+		p.done = true
+	}
+	first := p.page == p.options.Page
+	p.count += int64(len(items))
+	p.page++
+	switch {
+	case !p.options.AllPages || p.options.Size <= 0:
+		p.done = true
+	case total > 0:
+		p.done = p.done || (p.options.Page-1)*p.options.Size+p.received >= total
+	default:
+		p.done = p.done || int64(received) < p.options.Size
+	}
+	if received == 0 && !first {
+		return nil, false, nil
+	}
+	return items, true, nil
+}
+
+// Count returns the number of items returned so far.
+func (p *Paginator[T]) Count() int64 {
+	return p.count
+}
+
+// All retrieves all the remaining items.
+func (p *Paginator[T]) All() ([]T, error) {
+	var all []T
+	for {
+		items, ok, err := p.NextPage()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return all, nil
+		}
+		all = append(all, items...)
+	}
+}
@@ -0,0 +1,168 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package paginator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// fakeServer serves the given number of items, page by page, and records the requested pages.
+type fakeServer struct {
+	items     int
+	sendTotal bool
+	requests  [][2]int64
+}
+
+func (s *fakeServer) fetch(page, size int64) ([]int, int64, error) {
+	s.requests = append(s.requests, [2]int64{page, size})
+	first, last := 0, s.items
+	if size > 0 {
+		first = int((page - 1) * size)
+		last = first + int(size)
+	}
+	var items []int
+	for i := first; i < last && i < s.items; i++ {
+		items = append(items, i)
+	}
+	var total int64
+	if s.sendTotal {
+		total = int64(s.items)
+	}
+	return items, total, nil
+}
+
+func TestPaginator(t *testing.T) {
+	tests := map[string]struct {
+		items        int
+		sendTotal    bool
+		options      Options
+		wantItems    int
+		wantRequests [][2]int64
+	}{
+		"all pages with total": {
+			items:        5,
+			sendTotal:    true,
+			options:      Options{Size: 2, AllPages: true},
+			wantItems:    5,
+			wantRequests: [][2]int64{{1, 2}, {2, 2}, {3, 2}},
+		},
+		"all pages with full last page and total": {
+			items:        4,
+			sendTotal:    true,
+			options:      Options{Size: 2, AllPages: true},
+			wantItems:    4,
+			wantRequests: [][2]int64{{1, 2}, {2, 2}},
+		},
+		"all pages without total": {
+			items:        4,
+			options:      Options{Size: 2, AllPages: true},
+			wantItems:    4,
+			wantRequests: [][2]int64{{1, 2}, {2, 2}, {3, 2}},
+		},
+		"single page": {
+			items:        5,
+			sendTotal:    true,
+			options:      Options{Page: 2, Size: 2},
+			wantItems:    2,
+			wantRequests: [][2]int64{{2, 2}},
+		},
+		"no page size": {
+			items:        5,
+			options:      Options{AllPages: true},
+			wantItems:    5,
+			wantRequests: [][2]int64{{1, 0}},
+		},
+		"limit": {
+			items:        10,
+			sendTotal:    true,
+			options:      Options{Size: 3, Limit: 4, AllPages: true},
+			wantItems:    4,
+			wantRequests: [][2]int64{{1, 3}, {2, 3}},
+		},
+		"limit reached at the end of a page": {
+			items:        10,
+			sendTotal:    true,
+			options:      Options{Size: 2, Limit: 4, AllPages: true},
+			wantItems:    4,
+			wantRequests: [][2]int64{{1, 2}, {2, 2}},
+		},
+		"no items": {
+			options:      Options{Size: 2, AllPages: true},
+			wantItems:    0,
+			wantRequests: [][2]int64{{1, 2}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := &fakeServer{items: test.items, sendTotal: test.sendTotal}
+			p := New(test.options, server.fetch)
+			items, err := p.All()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if len(items) != test.wantItems || p.Count() != int64(test.wantItems) {
+				t.Errorf("expected %d items, got %d (count %d)", test.wantItems, len(items), p.Count())
+			}
+			if !reflect.DeepEqual(server.requests, test.wantRequests) {
+				t.Errorf("expected requests %v, got %v", test.wantRequests, server.requests)
+			}
+		})
+	}
+}
+
+func TestPaginatorFilter(t *testing.T) {
+	server := &fakeServer{items: 10, sendTotal: true}
+	p := New(Options{Size: 4, Limit: 3, AllPages: true}, server.fetch).
+		Filter(func(i int) bool { return i%2 == 1 })
+	items, err := p.All()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !reflect.DeepEqual(items, []int{1, 3, 5}) {
+		t.Errorf("expected items [1 3 5], got %v", items)
+	}
+	if len(server.requests) != 2 {
+		t.Errorf("expected 2 requests, got %v", server.requests)
+	}
+}
+
+func TestPaginatorFirstPageAlwaysReturned(t *testing.T) {
+	server := &fakeServer{}
+	p := New(Options{Size: 2, AllPages: true}, server.fetch)
+	items, ok, err := p.NextPage()
+	if err != nil || !ok || len(items) != 0 {
+		t.Errorf("expected an empty first page, got %v, %t, %v", items, ok, err)
+	}
+	if _, ok, _ := p.NextPage(); ok {
+		t.Errorf("expected no more pages")
+	}
+}
+
+func TestPaginatorError(t *testing.T) {
+	calls := 0
+	p := New(Options{Size: 2, AllPages: true}, func(page, size int64) ([]int, int64, error) {
+		calls++
+		if page == 2 {
+			return nil, 0, errors.New("server error")
+		}
+		return []int{1, 2}, 0, nil
+	})
+	if _, err := p.All(); err == nil || err.Error() != "server error" {
+		t.Errorf("expected server error, got %v", err)
+	}
+	if _, ok, err := p.NextPage(); ok || err != nil {
+		t.Errorf("expected the paginator to stop after an error")
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 requests, got %d", calls)
+	}
+}
@@ -0,0 +1,181 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+// Package quotahistory stores snapshots of the user quota in a local file and forecasts its usage.
+package quotahistory
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+)
+
+// Snapshot is the quota usage of all the resources of the user at a given time.
+type Snapshot struct {
+	Timestamp time.Time                   `json:"timestamp"`
+	Resources map[string]ResourceSnapshot `json:"resources"`
+}
+
+// ResourceSnapshot is the quota usage of a single resource at a given time.
+type ResourceSnapshot struct {
+	Usage              float64 `json:"usage"`
+	Limit              float64 `json:"limit"`
+	UsageHumanReadable string  `json:"usage_human_readable"`
+	LimitHumanReadable string  `json:"limit_human_readable"`
+	Health             string  `json:"health,omitempty"`
+}
+
+// Point is the usage of a resource at a given time.
+type Point struct {
+	Time  time.Time
+	Usage float64
+}
+
+// unsafeFileCharsRegex matches the characters that should not be part of a file name.
+var unsafeFileCharsRegex = regexp.MustCompile(`[^A-Za-z0-9.\-]+`)
+
+// DefaultPath returns the path of the history file of the given server,
+// inside the user configuration directory.
+func DefaultPath(serverURL string) (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	server := unsafeFileCharsRegex.ReplaceAllString(serverURL, "_")
+	return filepath.Join(
+		configDir,
+		"reana-client-go",
+		fmt.Sprintf("quota-history-%s.jsonl", server),
+	), nil
+}
+
+// Append adds the snapshot at the end of the history file, creating the file if needed.
+// Each snapshot is stored as a JSON document in its own line.
+func Append(path string, snapshot Snapshot) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(data, '\n'))
+	return err
+}
+
+// Load reads the snapshots of the history file, sorted by time.
+// A missing file is an empty history. Lines that cannot be parsed, e.g. when a
+// previous run was interrupted while writing, are ignored.
+func Load(path string) ([]Snapshot, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"history file %s could not be read: %s",
+			path, err.Error(),
+		)
+	}
+	defer file.Close()
+
+	var snapshots []Snapshot
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var snapshot Snapshot
+		if err := json.Unmarshal(scanner.Bytes(), &snapshot); err != nil {
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf(
+			"history file %s could not be read: %s",
+			path, err.Error(),
+		)
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].Timestamp.Before(snapshots[j].Timestamp)
+	})
+	return snapshots, nil
+}
+
+// Series returns the usage of the resource in the snapshots taken at or after since.
+// Snapshots not containing the resource are skipped.
+func Series(snapshots []Snapshot, resource string, since time.Time) []Point {
+	var points []Point
+	for _, snapshot := range snapshots {
+		if snapshot.Timestamp.Before(since) {
+			continue
+		}
+		stat, ok := snapshot.Resources[resource]
+		if !ok {
+			continue
+		}
+		points = append(points, Point{Time: snapshot.Timestamp, Usage: stat.Usage})
+	}
+	return points
+}
+
+// maxForecast is how far in the future the limit can be forecast to be reached.
+// Slower trends are considered not to reach the limit, which also keeps the forecast
+// within the range of time.Duration.
+const maxForecast = 100 * 365 * 24 * time.Hour
+
+// ForecastLimit fits a linear trend to the points and returns the time at which the usage reaches the limit.
+// Returns false if there are fewer than two points at different times, if the usage is not increasing,
+// or if the trend reaches the limit before the latest point or now, or more than maxForecast after now.
+func ForecastLimit(points []Point, limit float64, now time.Time) (time.Time, bool) {
+	if len(points) < 2 {
+		return time.Time{}, false
+	}
+
+	// Least squares regression of the usage over the seconds elapsed since the first point.
+	origin := points[0].Time
+	n := float64(len(points))
+	var sumX, sumY, sumXY, sumXX float64
+	for _, point := range points {
+		x := point.Time.Sub(origin).Seconds()
+		sumX += x
+		sumY += point.Usage
+		sumXY += x * point.Usage
+		sumXX += x * x
+	}
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return time.Time{}, false
+	}
+	slope := (n*sumXY - sumX*sumY) / denominator
+	if slope <= 0 {
+		return time.Time{}, false
+	}
+	intercept := (sumY - slope*sumX) / n
+
+	seconds := (limit - intercept) / slope
+	horizon := now.Add(maxForecast).Sub(origin).Seconds()
+	if seconds > horizon {
+		return time.Time{}, false
+	}
+	reachedAt := origin.Add(time.Duration(seconds * float64(time.Second)))
+	if !reachedAt.After(points[len(points)-1].Time) || !reachedAt.After(now) {
+		return time.Time{}, false
+	}
+	return reachedAt, true
+}
@@ -0,0 +1,148 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package quotahistory
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDefaultPath(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/tmp/config")
+	got, err := DefaultPath("https://reana.cern.ch:443")
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+	want := "/tmp/config/reana-client-go/quota-history-https_reana.cern.ch_443.jsonl"
+	if got != want {
+		t.Errorf("Expected '%s', got '%s'", want, got)
+	}
+}
+
+func TestAppendAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "history.jsonl")
+
+	snapshots, err := Load(path)
+	if err != nil || len(snapshots) != 0 {
+		t.Fatalf("Expected empty history for missing file, got %v, %v", snapshots, err)
+	}
+
+	later := Snapshot{
+		Timestamp: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		Resources: map[string]ResourceSnapshot{"disk": {Usage: 20, Limit: 100}},
+	}
+	earlier := Snapshot{
+		Timestamp: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Resources: map[string]ResourceSnapshot{"disk": {Usage: 10, Limit: 100}},
+	}
+	for _, snapshot := range []Snapshot{later, earlier} {
+		if err := Append(path, snapshot); err != nil {
+			t.Fatalf("Got unexpected error: %s", err.Error())
+		}
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+	if _, err := file.WriteString(`{"timestamp": "2026-03`); err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+	file.Close()
+
+	snapshots, err = Load(path)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+	if len(snapshots) != 2 {
+		t.Fatalf("Expected 2 snapshots, got %d", len(snapshots))
+	}
+	if !snapshots[0].Timestamp.Equal(earlier.Timestamp) ||
+		snapshots[1].Resources["disk"].Usage != 20 {
+		t.Errorf("Expected snapshots sorted by time, got %v", snapshots)
+	}
+}
+
+func TestLoadUnreadable(t *testing.T) {
+	_, err := Load(t.TempDir())
+	if err == nil || !strings.Contains(err.Error(), "could not be read") {
+		t.Errorf("Expected read error, got %v", err)
+	}
+}
+
+func TestSeries(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC) }
+	snapshots := []Snapshot{
+		{Timestamp: day(1), Resources: map[string]ResourceSnapshot{"cpu": {Usage: 1}}},
+		{Timestamp: day(2), Resources: map[string]ResourceSnapshot{"disk": {Usage: 2}}},
+		{Timestamp: day(3), Resources: map[string]ResourceSnapshot{"cpu": {Usage: 3}}},
+		{Timestamp: day(4), Resources: map[string]ResourceSnapshot{"cpu": {Usage: 4}}},
+	}
+
+	points := Series(snapshots, "cpu", day(2))
+	if len(points) != 2 || points[0].Usage != 3 || points[1].Usage != 4 {
+		t.Errorf("Expected cpu usage since day 2, got %v", points)
+	}
+}
+
+func TestForecastLimit(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC) }
+	now := day(3)
+	tests := map[string]struct {
+		points []Point
+		limit  float64
+		want   time.Time
+		ok     bool
+	}{
+		"linear growth": {
+			points: []Point{{day(1), 10}, {day(2), 20}, {day(3), 30}},
+			limit:  100,
+			want:   day(10),
+			ok:     true,
+		},
+		"not increasing": {
+			points: []Point{{day(1), 30}, {day(2), 20}},
+			limit:  100,
+		},
+		"constant usage": {
+			points: []Point{{day(1), 30}, {day(2), 30}},
+			limit:  100,
+		},
+		"single point": {
+			points: []Point{{day(1), 30}},
+			limit:  100,
+		},
+		"same time": {
+			points: []Point{{day(1), 10}, {day(1), 20}},
+			limit:  100,
+		},
+		"tiny slope": {
+			points: []Point{{day(1), 10}, {day(2), 10.000001}, {day(3), 10.000002}},
+			limit:  1e12,
+		},
+		"crossing in the past": {
+			points: []Point{{day(1), 0}, {day(2), 300}, {day(3), 40}},
+			limit:  100,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := ForecastLimit(test.points, test.limit, now)
+			if ok != test.ok {
+				t.Fatalf("Expected %t, got %t", test.ok, ok)
+			}
+			if ok && !got.Equal(test.want) {
+				t.Errorf("Expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
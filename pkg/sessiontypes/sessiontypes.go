@@ -0,0 +1,181 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+// Package sessiontypes describes the types of interactive sessions that can be opened on a workflow workspace.
+package sessiontypes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultType type of interactive session supported by every server, used when none is given.
+const DefaultType = "jupyter"
+
+// ServerConfigKey key of the server configuration advertising the supported types of interactive sessions.
+const ServerConfigKey = "interactive_session_types"
+
+// Type describes a type of interactive session and its options. The default image and the
+// resources are set by the server: the former is used when no image is requested, whereas
+// the latter cannot be requested by clients.
+type Type struct {
+	Name              string         `json:"name"`
+	Title             string         `json:"title,omitempty"`
+	DefaultImage      string         `json:"default_image,omitempty"`
+	RecommendedImages []string       `json:"recommended_images,omitempty"`
+	Resources         map[string]any `json:"resources,omitempty"`
+	ConnectionHint    string         `json:"connection_hint,omitempty"`
+}
+
+// Known definitions of the types of interactive sessions that the client knows how to describe.
+// They complete the definitions advertised by the server.
+var Known = map[string]Type{
+	"jupyter": {
+		Name:           "jupyter",
+		Title:          "Jupyter notebook",
+		ConnectionHint: "Open the URL in your web browser to use the Jupyter notebook.",
+	},
+	"vscode": {
+		Name:           "vscode",
+		Title:          "VS Code server",
+		ConnectionHint: "Open the URL in your web browser to use the VS Code editor.",
+	},
+	"rstudio": {
+		Name:           "rstudio",
+		Title:          "RStudio server",
+		ConnectionHint: "Open the URL in your web browser to use RStudio.",
+	},
+	"shell": {
+		Name:           "shell",
+		Title:          "Shell terminal",
+		ConnectionHint: "Open the URL in your web browser to get a terminal inside the workspace.",
+	},
+}
+
+// genericConnectionHint hint for the types of interactive sessions without a specific one.
+const genericConnectionHint = "Open the URL in your web browser."
+
+// Discover returns the types of interactive sessions supported by the server, according to
+// the given server configuration. The types can be advertised as a list of names, or as
+// a list of definitions with their options, possibly wrapped in a "value" field.
+// The default type is always supported. Types are sorted by name, with the default one first.
+func Discover(serverConfig any) ([]Type, error) {
+	types := map[string]Type{DefaultType: Known[DefaultType]}
+
+	configMap, _ := serverConfig.(map[string]any)
+	raw, advertised := configMap[ServerConfigKey]
+	if wrapped, ok := raw.(map[string]any); ok {
+		raw = wrapped["value"]
+	}
+	if advertised && raw != nil {
+		entries, ok := raw.([]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid '%s' in server configuration: expected a list", ServerConfigKey)
+		}
+		for _, entry := range entries {
+			sessionType, err := parseType(entry)
+			if err != nil {
+				return nil, err
+			}
+			types[sessionType.Name] = complete(sessionType)
+		}
+	}
+
+	result := make([]Type, 0, len(types))
+	for _, sessionType := range types {
+		result = append(result, sessionType)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name == DefaultType || result[j].Name == DefaultType {
+			return result[i].Name == DefaultType
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// Names returns the names of the given types of interactive sessions.
+func Names(types []Type) []string {
+	names := make([]string, len(types))
+	for i, sessionType := range types {
+		names[i] = sessionType.Name
+	}
+	return names
+}
+
+// Find returns the type of interactive session with the given name.
+func Find(types []Type, name string) (Type, bool) {
+	for _, sessionType := range types {
+		if sessionType.Name == name {
+			return sessionType, true
+		}
+	}
+	return Type{}, false
+}
+
+// Hint returns how to connect to an interactive session of the given type,
+// using the known definitions if the type was not discovered.
+func Hint(types []Type, name string) string {
+	if sessionType, found := Find(types, name); found {
+		return sessionType.ConnectionHint
+	}
+	if known, found := Known[name]; found {
+		return known.ConnectionHint
+	}
+	return genericConnectionHint
+}
+
+// FormatResources formats the resources of a type of interactive session as "key=value" pairs sorted by key.
+func FormatResources(resources map[string]any) string {
+	pairs := make([]string, 0, len(resources))
+	for key, value := range resources {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", key, value))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
+
+// parseType parses a type of interactive session advertised by the server, either a name or a definition.
+func parseType(entry any) (Type, error) {
+	var sessionType Type
+	switch value := entry.(type) {
+	case string:
+		sessionType.Name = value
+	case map[string]any:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return Type{}, err
+		}
+		if err := json.Unmarshal(data, &sessionType); err != nil {
+			return Type{}, fmt.Errorf("invalid interactive session type in server configuration: %s", err.Error())
+		}
+	}
+	sessionType.Name = strings.TrimSpace(sessionType.Name)
+	if sessionType.Name == "" {
+		return Type{}, errors.New("invalid interactive session type in server configuration: missing name")
+	}
+	return sessionType, nil
+}
+
+// complete fills the title and the connection hint of the given type from the known definitions.
+func complete(sessionType Type) Type {
+	known, found := Known[sessionType.Name]
+	if sessionType.Title == "" {
+		sessionType.Title = known.Title
+	}
+	if sessionType.ConnectionHint == "" {
+		sessionType.ConnectionHint = known.ConnectionHint
+	}
+	if !found && sessionType.ConnectionHint == "" {
+		sessionType.ConnectionHint = genericConnectionHint
+	}
+	return sessionType
+}
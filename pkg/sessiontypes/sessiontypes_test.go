@@ -0,0 +1,138 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package sessiontypes
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiscover(t *testing.T) {
+	tests := map[string]struct {
+		serverConfig any
+		wantNames    []string
+		wantError    bool
+	}{
+		"no configuration": {
+			serverConfig: nil,
+			wantNames:    []string{"jupyter"},
+		},
+		"no session types": {
+			serverConfig: map[string]any{"docs_url": "https://docs.reana.io"},
+			wantNames:    []string{"jupyter"},
+		},
+		"list of names": {
+			serverConfig: map[string]any{
+				"interactive_session_types": []any{"vscode", "jupyter", "rstudio"},
+			},
+			wantNames: []string{"jupyter", "rstudio", "vscode"},
+		},
+		"wrapped value": {
+			serverConfig: map[string]any{
+				"interactive_session_types": map[string]any{
+					"title": "Interactive session types",
+					"value": []any{"shell"},
+				},
+			},
+			wantNames: []string{"jupyter", "shell"},
+		},
+		"definitions": {
+			serverConfig: map[string]any{
+				"interactive_session_types": []any{
+					map[string]any{"name": "rstudio", "default_image": "rocker/rstudio"},
+				},
+			},
+			wantNames: []string{"jupyter", "rstudio"},
+		},
+		"not a list": {
+			serverConfig: map[string]any{"interactive_session_types": "rstudio"},
+			wantError:    true,
+		},
+		"missing name": {
+			serverConfig: map[string]any{
+				"interactive_session_types": []any{map[string]any{"title": "RStudio"}},
+			},
+			wantError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			types, err := Discover(test.serverConfig)
+			if test.wantError {
+				if err == nil {
+					t.Errorf("Expected error, got %v", types)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Got unexpected error: %s", err.Error())
+			}
+			if got := Names(types); !reflect.DeepEqual(got, test.wantNames) {
+				t.Errorf("Expected %v, got %v", test.wantNames, got)
+			}
+		})
+	}
+}
+
+func TestDiscoverCompletesDefinitions(t *testing.T) {
+	types, err := Discover(map[string]any{
+		"interactive_session_types": []any{
+			map[string]any{
+				"name":               "rstudio",
+				"default_image":      "rocker/rstudio",
+				"recommended_images": []any{"rocker/rstudio", "rocker/tidyverse"},
+				"resources":          map[string]any{"memory": "4Gi", "cpu": 2},
+			},
+			"custom",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+
+	rstudio, found := Find(types, "rstudio")
+	if !found {
+		t.Fatalf("Expected rstudio to be discovered, got %v", Names(types))
+	}
+	if rstudio.Title != "RStudio server" || rstudio.ConnectionHint != Known["rstudio"].ConnectionHint {
+		t.Errorf("Expected known title and hint, got '%s' and '%s'", rstudio.Title, rstudio.ConnectionHint)
+	}
+	if rstudio.DefaultImage != "rocker/rstudio" || len(rstudio.RecommendedImages) != 2 {
+		t.Errorf("Expected advertised images, got %v", rstudio)
+	}
+	if got := FormatResources(rstudio.Resources); got != "cpu=2, memory=4Gi" {
+		t.Errorf("Expected 'cpu=2, memory=4Gi', got '%s'", got)
+	}
+
+	custom, _ := Find(types, "custom")
+	if custom.ConnectionHint != genericConnectionHint {
+		t.Errorf("Expected generic hint for unknown type, got '%s'", custom.ConnectionHint)
+	}
+}
+
+func TestHint(t *testing.T) {
+	types := []Type{{Name: "rstudio", ConnectionHint: "Use the server hint."}}
+	tests := map[string]struct {
+		name string
+		want string
+	}{
+		"discovered type": {name: "rstudio", want: "Use the server hint."},
+		"known type":      {name: "vscode", want: Known["vscode"].ConnectionHint},
+		"unknown type":    {name: "other", want: genericConnectionHint},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := Hint(types, test.name); got != test.want {
+				t.Errorf("Expected '%s', got '%s'", test.want, got)
+			}
+		})
+	}
+}
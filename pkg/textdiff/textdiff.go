@@ -0,0 +1,227 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+// Package textdiff computes the differences between the contents of files in unified diff format.
+package textdiff
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxEdits maximum number of inserted and deleted lines for which the differences are computed,
+// as the memory needed grows with its square.
+const MaxEdits = 5000
+
+// SniffLength number of bytes at the start of the data inspected by IsBinary and IsROOT,
+// which is enough to detect the type of a file without reading it fully.
+const SniffLength = 8000
+
+// rootMagic bytes at the start of every ROOT file.
+var rootMagic = []byte("root")
+
+// edit is a line of the edit script transforming a text into another,
+// kind being ' ' for an unchanged line, '-' for a deleted line or '+' for an inserted line.
+type edit struct {
+	kind byte
+	line string
+}
+
+// Unified returns the differences between texts a and b in unified diff format, starting with
+// the "--- nameA" and "+++ nameB" headers, with the given number of context lines around changes.
+// Returns no lines if the texts are equal and false if there are more than MaxEdits differences.
+func Unified(a, b, nameA, nameB string, context int) ([]string, bool) {
+	edits, ok := editScript(SplitLines(a), SplitLines(b))
+	if !ok {
+		return nil, false
+	}
+	hunks := unifiedHunks(edits, context)
+	if len(hunks) == 0 {
+		return nil, true
+	}
+	return append([]string{"--- " + nameA, "+++ " + nameB}, hunks...), true
+}
+
+// SplitLines splits a text into lines, without the line terminators.
+func SplitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	return lines
+}
+
+// IsBinary returns whether the data looks like binary content rather than text,
+// i.e. if it contains NUL bytes or is not valid UTF-8.
+func IsBinary(data []byte) bool {
+	sniff := data
+	if len(sniff) >= SniffLength {
+		// The data may have been cut, e.g. if it is only the start of a file
+		sniff = sniff[:SniffLength]
+		// Do not cut a multi-byte character in half
+		for i := 0; i < utf8.UTFMax && !utf8.Valid(sniff); i++ {
+			sniff = sniff[:len(sniff)-1]
+		}
+	}
+	return bytes.IndexByte(sniff, 0) != -1 || !utf8.Valid(sniff)
+}
+
+// IsROOT returns whether the data is a ROOT file, which is binary and starts with the "root" magic bytes.
+func IsROOT(data []byte) bool {
+	return bytes.HasPrefix(data, rootMagic) && IsBinary(data)
+}
+
+// editScript computes the shortest edit script transforming lines a into lines b with Myers' algorithm.
+// Returns false if the script has more than MaxEdits insertions and deletions.
+func editScript(a, b []string) ([]edit, bool) {
+	n, m := len(a), len(b)
+	maxEdits := n + m
+	if maxEdits > MaxEdits {
+		maxEdits = MaxEdits
+	}
+	offset := maxEdits + 1
+	v := make([]int, 2*offset+1)
+	// trace[d] contains the furthest reaching x of the diagonals -d..d after d edits
+	var trace [][]int
+
+	for d := 0; d <= maxEdits; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+		}
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+		if k := n - m; k >= -d && k <= d && (k+d)%2 == 0 && v[offset+k] >= n {
+			return backtrack(trace, a, b), true
+		}
+	}
+	return nil, false
+}
+
+// backtrack follows the trace of editScript backwards to build the edit script.
+func backtrack(trace [][]int, a, b []string) []edit {
+	var edits []edit
+	x, y := len(a), len(b)
+	furthest := func(d, k int) int {
+		if d < 0 {
+			return 0
+		}
+		return trace[d][k+d]
+	}
+	for d := len(trace) - 1; d >= 0; d-- {
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && furthest(d-1, k-1) < furthest(d-1, k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := furthest(d-1, prevK)
+		prevY := prevX - prevK
+		if d == 0 {
+			prevX, prevY = 0, 0
+		}
+		for x > prevX && y > prevY {
+			edits = append(edits, edit{kind: ' ', line: a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				edits = append(edits, edit{kind: '+', line: b[y-1]})
+			} else {
+				edits = append(edits, edit{kind: '-', line: a[x-1]})
+			}
+			x, y = prevX, prevY
+		}
+	}
+	for i, j := 0, len(edits)-1; i < j; i, j = i+1, j-1 {
+		edits[i], edits[j] = edits[j], edits[i]
+	}
+	return edits
+}
+
+// unifiedHunks groups the changes of the edit script into hunks with the given number of context lines.
+func unifiedHunks(edits []edit, context int) []string {
+	if context < 0 {
+		context = 0
+	}
+	var changes []int
+	for i, e := range edits {
+		if e.kind != ' ' {
+			changes = append(changes, i)
+		}
+	}
+
+	var lines []string
+	for i := 0; i < len(changes); {
+		// Merge the changes separated by at most twice the context lines
+		j := i
+		for j+1 < len(changes) && changes[j+1]-changes[j] <= 2*context+1 {
+			j++
+		}
+		start := max(changes[i]-context, 0)
+		end := min(changes[j]+context+1, len(edits))
+
+		startA, startB := 1, 1
+		for _, e := range edits[:start] {
+			if e.kind != '+' {
+				startA++
+			}
+			if e.kind != '-' {
+				startB++
+			}
+		}
+		var countA, countB int
+		hunk := make([]string, 0, end-start)
+		for _, e := range edits[start:end] {
+			if e.kind != '+' {
+				countA++
+			}
+			if e.kind != '-' {
+				countB++
+			}
+			hunk = append(hunk, string(e.kind)+e.line)
+		}
+		lines = append(lines, fmt.Sprintf(
+			"@@ -%s +%s @@",
+			hunkRange(startA, countA),
+			hunkRange(startB, countB),
+		))
+		lines = append(lines, hunk...)
+		i = j + 1
+	}
+	return lines
+}
+
+// hunkRange formats the range of lines of a hunk, e.g. "3,4", "3" for a single line
+// or "2,0" for an empty range after line 2.
+func hunkRange(start, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", start-1)
+	case 1:
+		return fmt.Sprintf("%d", start)
+	default:
+		return fmt.Sprintf("%d,%d", start, count)
+	}
+}
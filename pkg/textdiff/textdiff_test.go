@@ -0,0 +1,137 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package textdiff
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+func TestUnified(t *testing.T) {
+	tests := map[string]struct {
+		a, b     string
+		context  int
+		expected []string
+	}{
+		"equal texts": {
+			a:       "a\nb\n",
+			b:       "a\nb\n",
+			context: 3,
+		},
+		"empty texts": {},
+		"changed line": {
+			a:       "a\nb\nc\n",
+			b:       "a\nB\nc\n",
+			context: 3,
+			expected: []string{
+				"--- a.txt", "+++ b.txt",
+				"@@ -1,3 +1,3 @@", " a", "-b", "+B", " c",
+			},
+		},
+		"added file": {
+			b:       "a\nb\n",
+			context: 3,
+			expected: []string{
+				"--- a.txt", "+++ b.txt",
+				"@@ -0,0 +1,2 @@", "+a", "+b",
+			},
+		},
+		"removed lines": {
+			a:       "a\nb\nc\nd\n",
+			b:       "a\nd\n",
+			context: 0,
+			expected: []string{
+				"--- a.txt", "+++ b.txt",
+				"@@ -2,2 +1,0 @@", "-b", "-c",
+			},
+		},
+		"separate hunks": {
+			a:       "1\n2\n3\n4\n5\n6\n7\n8\n",
+			b:       "0\n2\n3\n4\n5\n6\n7\n9\n",
+			context: 1,
+			expected: []string{
+				"--- a.txt", "+++ b.txt",
+				"@@ -1,2 +1,2 @@", "-1", "+0", " 2",
+				"@@ -7,2 +7,2 @@", " 7", "-8", "+9",
+			},
+		},
+		"merged hunks": {
+			a:       "1\n2\n3\n4\n",
+			b:       "0\n2\n3\n5\n",
+			context: 1,
+			expected: []string{
+				"--- a.txt", "+++ b.txt",
+				"@@ -1,4 +1,4 @@", "-1", "+0", " 2", " 3", "-4", "+5",
+			},
+		},
+		"windows line endings": {
+			a:       "a\r\nb\r\n",
+			b:       "a\nb",
+			context: 3,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := Unified(test.a, test.b, "a.txt", "b.txt", test.context)
+			if !ok {
+				t.Fatalf("expected differences to be computed")
+			}
+			if !slices.Equal(got, test.expected) {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestUnifiedTooManyEdits(t *testing.T) {
+	var a, b strings.Builder
+	for i := 0; i <= MaxEdits/2; i++ {
+		a.WriteString("a\n")
+		b.WriteString("b\n")
+	}
+	if _, ok := Unified(a.String(), b.String(), "a", "b", 3); ok {
+		t.Errorf("expected differences not to be computed")
+	}
+}
+
+func TestIsBinary(t *testing.T) {
+	tests := map[string]struct {
+		data   []byte
+		binary bool
+		root   bool
+	}{
+		"empty":       {data: []byte{}},
+		"text":        {data: []byte("hello\nworld\n")},
+		"utf-8 text":  {data: []byte("héllo wörld")},
+		"nul byte":    {data: []byte("a\x00b"), binary: true},
+		"invalid utf": {data: []byte{0xff, 0xfe, 'a'}, binary: true},
+		"root file":   {data: []byte("root\x00\x00\xf4\x1e"), binary: true, root: true},
+		"root text":   {data: []byte("root directory")},
+		"long text cut in a character": {
+			data: []byte(strings.Repeat("a", SniffLength-1) + "é"),
+		},
+		"start of text cut in a character": {
+			data: []byte(strings.Repeat("a", SniffLength-1) + "é")[:SniffLength],
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsBinary(test.data); got != test.binary {
+				t.Errorf("expected IsBinary %t, got %t", test.binary, got)
+			}
+			if got := IsROOT(test.data); got != test.root {
+				t.Errorf("expected IsROOT %t, got %t", test.root, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+// Package timeline analyses when the jobs of a workflow ran, to render them as a Gantt chart or a trace.
+package timeline
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Job is a job of a workflow that has started.
+type Job struct {
+	ID      string
+	Step    string
+	Status  string
+	Backend string
+	Start   time.Time
+	End     time.Time
+	// Running is true if the job has not finished yet, in which case End is the current time.
+	Running bool
+}
+
+// Duration returns how long the job ran.
+func (j Job) Duration() time.Duration {
+	return j.End.Sub(j.Start)
+}
+
+// StepStats are the statistics of the durations of the jobs of a step.
+type StepStats struct {
+	Step   string
+	Jobs   int
+	Failed int
+	Total  time.Duration
+	Mean   time.Duration
+	Min    time.Duration
+	Max    time.Duration
+}
+
+// Sort sorts the jobs by start time, then by end time and ID.
+func Sort(jobs []Job) {
+	sort.SliceStable(jobs, func(i, j int) bool {
+		if !jobs[i].Start.Equal(jobs[j].Start) {
+			return jobs[i].Start.Before(jobs[j].Start)
+		}
+		if !jobs[i].End.Equal(jobs[j].End) {
+			return jobs[i].End.Before(jobs[j].End)
+		}
+		return jobs[i].ID < jobs[j].ID
+	})
+}
+
+// Span returns the start of the first job and the end of the last one.
+func Span(jobs []Job) (time.Time, time.Time) {
+	var start, end time.Time
+	for i, job := range jobs {
+		if i == 0 || job.Start.Before(start) {
+			start = job.Start
+		}
+		if i == 0 || job.End.After(end) {
+			end = job.End
+		}
+	}
+	return start, end
+}
+
+// CriticalPath returns the indexes of the jobs on the critical path, in chronological order.
+// As the dependencies between jobs are not known, a job is considered to depend on the jobs
+// that finished before it started. The path starts from the job that finished last and goes
+// back, at each step, to the job that finished last before the current one started, i.e. the
+// one that most likely delayed it.
+func CriticalPath(jobs []Job) []int {
+	current := -1
+	for i, job := range jobs {
+		if current < 0 || job.End.After(jobs[current].End) {
+			current = i
+		}
+	}
+
+	var path []int
+	// Jobs that did not last can both start and finish when the current one starts, so the jobs
+	// already on the path are skipped.
+	onPath := make(map[int]bool)
+	for current >= 0 {
+		path = append(path, current)
+		onPath[current] = true
+		previous := -1
+		for i, job := range jobs {
+			if onPath[i] || job.End.After(jobs[current].Start) {
+				continue
+			}
+			if previous < 0 || job.End.After(jobs[previous].End) {
+				previous = i
+			}
+		}
+		current = previous
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// ComputeStepStats returns the statistics of each step, sorted by decreasing total duration.
+func ComputeStepStats(jobs []Job) []StepStats {
+	statsByStep := make(map[string]*StepStats)
+	var steps []string
+	for _, job := range jobs {
+		stats, exists := statsByStep[job.Step]
+		if !exists {
+			stats = &StepStats{Step: job.Step, Min: job.Duration(), Max: job.Duration()}
+			statsByStep[job.Step] = stats
+			steps = append(steps, job.Step)
+		}
+		duration := job.Duration()
+		stats.Jobs++
+		stats.Total += duration
+		if duration < stats.Min {
+			stats.Min = duration
+		}
+		if duration > stats.Max {
+			stats.Max = duration
+		}
+		if job.Status == "failed" {
+			stats.Failed++
+		}
+	}
+
+	result := make([]StepStats, 0, len(steps))
+	for _, step := range steps {
+		stats := statsByStep[step]
+		stats.Mean = stats.Total / time.Duration(stats.Jobs)
+		result = append(result, *stats)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Total != result[j].Total {
+			return result[i].Total > result[j].Total
+		}
+		return result[i].Step < result[j].Step
+	})
+	return result
+}
+
+// Bar returns an ASCII bar of the given width, representing when the job ran between start and end.
+// The bar is drawn with the fill character, and ends with '>' if the job is still running.
+// Jobs are always at least one character long, so that short jobs remain visible.
+func Bar(job Job, start, end time.Time, width int, fill rune) string {
+	if width <= 0 {
+		return ""
+	}
+	total := end.Sub(start)
+	position := func(t time.Time) int {
+		if total <= 0 {
+			return 0
+		}
+		return int(math.Round(float64(t.Sub(start)) / float64(total) * float64(width)))
+	}
+
+	from, to := position(job.Start), position(job.End)
+	if from >= width {
+		from = width - 1
+	}
+	if to <= from {
+		to = from + 1
+	}
+	if to > width {
+		to = width
+	}
+
+	bar := []rune(strings.Repeat(" ", width))
+	for i := from; i < to; i++ {
+		bar[i] = fill
+	}
+	if job.Running {
+		bar[to-1] = '>'
+	}
+	return string(bar)
+}
+
+// Lanes assigns each job to a lane so that jobs of the same lane do not overlap in time,
+// using as few lanes as possible. The jobs must be sorted by start time.
+func Lanes(jobs []Job) []int {
+	lanes := make([]int, len(jobs))
+	var laneEnds []time.Time
+	for i, job := range jobs {
+		lane := -1
+		for l, laneEnd := range laneEnds {
+			if !laneEnd.After(job.Start) {
+				lane = l
+				break
+			}
+		}
+		if lane < 0 {
+			lane = len(laneEnds)
+			laneEnds = append(laneEnds, time.Time{})
+		}
+		laneEnds[lane] = job.End
+		lanes[i] = lane
+	}
+	return lanes
+}
+
+// Trace is a trace in the Chrome trace-event format, which can be opened in Perfetto or chrome://tracing.
+type Trace struct {
+	TraceEvents     []TraceEvent `json:"traceEvents"`
+	DisplayTimeUnit string       `json:"displayTimeUnit"`
+}
+
+// TraceEvent is an event of a Chrome trace. Timestamps and durations are in microseconds.
+type TraceEvent struct {
+	Name      string         `json:"name"`
+	Category  string         `json:"cat,omitempty"`
+	Phase     string         `json:"ph"`
+	Timestamp int64          `json:"ts"`
+	Duration  int64          `json:"dur"`
+	ProcessID int            `json:"pid"`
+	ThreadID  int            `json:"tid"`
+	Args      map[string]any `json:"args,omitempty"`
+}
+
+// BuildTrace returns the trace of the jobs, one complete event per job, named after its step.
+// The jobs are spread over as few threads as possible, see Lanes, and the jobs on the critical
+// path are marked in their arguments. The jobs must be sorted by start time.
+func BuildTrace(process string, jobs []Job, criticalPath []int) Trace {
+	critical := make(map[int]bool, len(criticalPath))
+	for _, i := range criticalPath {
+		critical[i] = true
+	}
+
+	events := []TraceEvent{{
+		Name:      "process_name",
+		Phase:     "M",
+		ProcessID: 1,
+		Args:      map[string]any{"name": process},
+	}}
+	for i, lane := range Lanes(jobs) {
+		job := jobs[i]
+		events = append(events, TraceEvent{
+			Name:      job.Step,
+			Category:  job.Backend,
+			Phase:     "X",
+			Timestamp: job.Start.UnixMicro(),
+			Duration:  job.Duration().Microseconds(),
+			ProcessID: 1,
+			ThreadID:  lane + 1,
+			Args: map[string]any{
+				"job_id":        job.ID,
+				"status":        job.Status,
+				"running":       job.Running,
+				"critical_path": critical[i],
+			},
+		})
+	}
+	return Trace{TraceEvents: events, DisplayTimeUnit: "ms"}
+}
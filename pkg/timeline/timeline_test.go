@@ -0,0 +1,155 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package timeline
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+var origin = time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+// newJob returns a job of the given step, running between the given minutes after origin.
+func newJob(id, step string, from, to int) Job {
+	return Job{
+		ID:     id,
+		Step:   step,
+		Status: "finished",
+		Start:  origin.Add(time.Duration(from) * time.Minute),
+		End:    origin.Add(time.Duration(to) * time.Minute),
+	}
+}
+
+func TestSortAndSpan(t *testing.T) {
+	jobs := []Job{newJob("3", "plot", 30, 40), newJob("1", "fetch", 0, 10), newJob("2", "fit", 0, 30)}
+	Sort(jobs)
+	var ids []string
+	for _, job := range jobs {
+		ids = append(ids, job.ID)
+	}
+	if !reflect.DeepEqual(ids, []string{"1", "2", "3"}) {
+		t.Errorf("unexpected order %v", ids)
+	}
+
+	start, end := Span(jobs)
+	if !start.Equal(origin) || !end.Equal(origin.Add(40*time.Minute)) {
+		t.Errorf("unexpected span %s - %s", start, end)
+	}
+}
+
+func TestCriticalPath(t *testing.T) {
+	tests := map[string]struct {
+		jobs []Job
+		want []int
+	}{
+		"no jobs": {},
+		"serial": {
+			jobs: []Job{newJob("1", "a", 0, 10), newJob("2", "b", 10, 20), newJob("3", "c", 20, 25)},
+			want: []int{0, 1, 2},
+		},
+		"parallel": {
+			jobs: []Job{
+				newJob("1", "fetch", 0, 5),
+				newJob("2", "fit", 5, 30),
+				newJob("3", "fit", 5, 12),
+				newJob("4", "merge", 30, 35),
+			},
+			want: []int{0, 1, 3},
+		},
+		"instantaneous jobs": {
+			jobs: []Job{newJob("1", "a", 0, 0), newJob("2", "b", 0, 0)},
+			want: []int{1, 0},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CriticalPath(test.jobs)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestComputeStepStats(t *testing.T) {
+	failed := newJob("3", "fit", 5, 15)
+	failed.Status = "failed"
+	jobs := []Job{newJob("1", "fetch", 0, 5), newJob("2", "fit", 5, 35), failed}
+
+	want := []StepStats{
+		{
+			Step: "fit", Jobs: 2, Failed: 1, Total: 40 * time.Minute,
+			Mean: 20 * time.Minute, Min: 10 * time.Minute, Max: 30 * time.Minute,
+		},
+		{
+			Step: "fetch", Jobs: 1, Total: 5 * time.Minute,
+			Mean: 5 * time.Minute, Min: 5 * time.Minute, Max: 5 * time.Minute,
+		},
+	}
+	if got := ComputeStepStats(jobs); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestBar(t *testing.T) {
+	start, end := origin, origin.Add(40*time.Minute)
+	tests := map[string]struct {
+		job  Job
+		want string
+	}{
+		"whole span": {job: newJob("1", "a", 0, 40), want: "=========="},
+		"middle":     {job: newJob("1", "a", 8, 20), want: "  ===     "},
+		"short job":  {job: newJob("1", "a", 40, 40), want: "         ="},
+		"running": {
+			job:  Job{Start: origin.Add(20 * time.Minute), End: end, Running: true},
+			want: "     ====>",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := Bar(test.job, start, end, 10, '='); got != test.want {
+				t.Errorf("expected %q, got %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestLanes(t *testing.T) {
+	jobs := []Job{
+		newJob("1", "a", 0, 10),
+		newJob("2", "b", 0, 5),
+		newJob("3", "c", 5, 10),
+		newJob("4", "d", 10, 20),
+	}
+	if got := Lanes(jobs); !reflect.DeepEqual(got, []int{0, 1, 1, 0}) {
+		t.Errorf("unexpected lanes %v", got)
+	}
+}
+
+func TestBuildTrace(t *testing.T) {
+	jobs := []Job{newJob("1", "fetch", 0, 5), newJob("2", "fit", 5, 30)}
+	jobs[1].Backend = "kubernetes"
+	trace := BuildTrace("myanalysis.1", jobs, []int{1})
+
+	if len(trace.TraceEvents) != 3 || trace.TraceEvents[0].Phase != "M" {
+		t.Fatalf("unexpected events %+v", trace.TraceEvents)
+	}
+	fit := trace.TraceEvents[2]
+	if fit.Name != "fit" || fit.Category != "kubernetes" || fit.Phase != "X" ||
+		fit.Timestamp != origin.Add(5*time.Minute).UnixMicro() ||
+		fit.Duration != (25*time.Minute).Microseconds() || fit.ThreadID != 1 {
+		t.Errorf("unexpected event %+v", fit)
+	}
+	if fit.Args["critical_path"] != true || trace.TraceEvents[1].Args["critical_path"] != false {
+		t.Errorf("unexpected critical path arguments")
+	}
+}
@@ -0,0 +1,153 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+// Package usergroups manages local named groups of users, such as @higgs-team, used when sharing workflows.
+package usergroups
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Prefix marks a group name among user emails.
+const Prefix = "@"
+
+// groupNameRegex valid group names.
+var groupNameRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// Groups maps the name of each group to the emails of its members.
+type Groups map[string][]string
+
+// DefaultPath returns the path of the file storing the groups, inside the user configuration directory.
+func DefaultPath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "reana-client-go", "groups.json"), nil
+}
+
+// ValidateName verifies that the group name, with or without the leading @, is valid.
+func ValidateName(name string) error {
+	if !groupNameRegex.MatchString(strings.TrimPrefix(name, Prefix)) {
+		return fmt.Errorf(
+			"invalid group name '%s': it must start with a letter or a digit and contain only letters, digits, '.', '_' and '-'",
+			name,
+		)
+	}
+	return nil
+}
+
+// Load reads the groups stored in the given file. A missing file means there are no groups.
+func Load(path string) (Groups, error) {
+	groups := Groups{}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return groups, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("groups file %s could not be read", path)
+	}
+	if err := json.Unmarshal(data, &groups); err != nil {
+		return nil, fmt.Errorf("groups file %s is not valid: %s", path, err.Error())
+	}
+	return groups, nil
+}
+
+// Save writes the groups to the given file, creating its directory if needed.
+func Save(path string, groups Groups) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(groups, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
+
+// Add adds the members to the group, creating it if needed. Members already in the group are ignored.
+func (g Groups) Add(name string, members []string) {
+	name = strings.TrimPrefix(name, Prefix)
+	for _, member := range members {
+		if !slices.Contains(g[name], member) {
+			g[name] = append(g[name], member)
+		}
+	}
+	sort.Strings(g[name])
+}
+
+// Remove removes the members from the group, or the whole group if no members are given.
+// Empty groups are deleted. Returns an error if the group does not exist.
+func (g Groups) Remove(name string, members []string) error {
+	name = strings.TrimPrefix(name, Prefix)
+	current, exists := g[name]
+	if !exists {
+		return fmt.Errorf("group %s%s does not exist", Prefix, name)
+	}
+	if len(members) == 0 {
+		delete(g, name)
+		return nil
+	}
+
+	var remaining []string
+	for _, member := range current {
+		if !slices.Contains(members, member) {
+			remaining = append(remaining, member)
+		}
+	}
+	if len(remaining) == 0 {
+		delete(g, name)
+	} else {
+		g[name] = remaining
+	}
+	return nil
+}
+
+// Names returns the names of the groups, sorted alphabetically.
+func (g Groups) Names() []string {
+	names := make([]string, 0, len(g))
+	for name := range g {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Expand replaces the group names starting with @ by the emails of their members.
+// Duplicated users are only returned once, keeping the order in which they first appear.
+func (g Groups) Expand(users []string) ([]string, error) {
+	var expanded []string
+	add := func(user string) {
+		if !slices.Contains(expanded, user) {
+			expanded = append(expanded, user)
+		}
+	}
+
+	for _, user := range users {
+		if !strings.HasPrefix(user, Prefix) {
+			add(user)
+			continue
+		}
+		members, exists := g[strings.TrimPrefix(user, Prefix)]
+		if !exists {
+			return nil, fmt.Errorf("group %s does not exist", user)
+		}
+		for _, member := range members {
+			add(member)
+		}
+	}
+	return expanded, nil
+}
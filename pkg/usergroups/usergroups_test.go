@@ -0,0 +1,148 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package usergroups
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestValidateName(t *testing.T) {
+	tests := map[string]struct {
+		name      string
+		wantError bool
+	}{
+		"simple":        {name: "higgs-team"},
+		"with prefix":   {name: "@higgs.team_2"},
+		"empty":         {name: "@", wantError: true},
+		"space":         {name: "higgs team", wantError: true},
+		"leading dash":  {name: "-team", wantError: true},
+		"email address": {name: "bob@cern.ch", wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateName(test.name)
+			if test.wantError && err == nil {
+				t.Errorf("Expected error for '%s'", test.name)
+			}
+			if !test.wantError && err != nil {
+				t.Errorf("Got unexpected error: %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestLoadAndSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config", "groups.json")
+
+	groups, err := Load(path)
+	if err != nil {
+		t.Fatalf("Got unexpected error loading missing file: %s", err.Error())
+	}
+	if len(groups) != 0 {
+		t.Errorf("Expected no groups, got %v", groups)
+	}
+
+	groups.Add("@higgs-team", []string{"cecile@cern.ch", "bob@cern.ch"})
+	if err := Save(path, groups); err != nil {
+		t.Fatalf("Got unexpected error saving: %s", err.Error())
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Got unexpected error loading: %s", err.Error())
+	}
+	want := Groups{"higgs-team": {"bob@cern.ch", "cecile@cern.ch"}}
+	if !reflect.DeepEqual(loaded, want) {
+		t.Errorf("Expected %v, got %v", want, loaded)
+	}
+
+	if err := os.WriteFile(path, []byte("not json"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("Expected error loading invalid file")
+	}
+}
+
+func TestAddAndRemove(t *testing.T) {
+	groups := Groups{}
+	groups.Add("team", []string{"bob@cern.ch"})
+	groups.Add("team", []string{"alice@cern.ch", "bob@cern.ch"})
+	if want := []string{"alice@cern.ch", "bob@cern.ch"}; !reflect.DeepEqual(groups["team"], want) {
+		t.Errorf("Expected %v, got %v", want, groups["team"])
+	}
+
+	if err := groups.Remove("@team", []string{"bob@cern.ch"}); err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+	if want := []string{"alice@cern.ch"}; !reflect.DeepEqual(groups["team"], want) {
+		t.Errorf("Expected %v, got %v", want, groups["team"])
+	}
+
+	if err := groups.Remove("team", []string{"alice@cern.ch"}); err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+	if _, exists := groups["team"]; exists {
+		t.Error("Expected empty group to be deleted")
+	}
+
+	groups.Add("other", []string{"bob@cern.ch"})
+	if err := groups.Remove("other", nil); err != nil {
+		t.Fatalf("Got unexpected error: %s", err.Error())
+	}
+	if err := groups.Remove("other", nil); err == nil {
+		t.Error("Expected error removing missing group")
+	}
+}
+
+func TestExpand(t *testing.T) {
+	groups := Groups{
+		"higgs-team": {"alice@cern.ch", "bob@cern.ch"},
+		"reviewers":  {"bob@cern.ch", "cecile@cern.ch"},
+	}
+	tests := map[string]struct {
+		users     []string
+		want      []string
+		wantError bool
+	}{
+		"only emails": {
+			users: []string{"dan@cern.ch"},
+			want:  []string{"dan@cern.ch"},
+		},
+		"groups and emails": {
+			users: []string{"dan@cern.ch", "@higgs-team", "@reviewers", "alice@cern.ch"},
+			want:  []string{"dan@cern.ch", "alice@cern.ch", "bob@cern.ch", "cecile@cern.ch"},
+		},
+		"missing group": {
+			users:     []string{"@unknown"},
+			wantError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := groups.Expand(test.users)
+			if test.wantError {
+				if err == nil {
+					t.Errorf("Expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Got unexpected error: %s", err.Error())
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
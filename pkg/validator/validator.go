@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -20,6 +20,7 @@ import (
 	"os"
 	"reanahub/reana-client-go/pkg/config"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 
@@ -157,3 +158,21 @@ func ValidateFile(path string) error {
 	}
 	return nil
 }
+
+// ValidateFutureDate verifies if the given value is a date in the format YYYY-MM-DD that is not before today.
+// The second parameter, name, is the name of the flag that should be displayed if the validation fails.
+func ValidateFutureDate(value string, name string) error {
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return fmt.Errorf(
+			"invalid value for '%s': '%s' is not a valid date in the format YYYY-MM-DD",
+			name,
+			value,
+		)
+	}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	if date.Before(today) {
+		return fmt.Errorf("invalid value for '%s': '%s' is in the past", name, value)
+	}
+	return nil
+}
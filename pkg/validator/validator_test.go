@@ -1,6 +1,6 @@
 /*
 This file is part of REANA.
-Copyright (C) 2022, 2025 CERN.
+Copyright (C) 2022, 2025, 2026 CERN.
 
 REANA is free software; you can redistribute it and/or modify it
 under the terms of the MIT License; see LICENSE file for more details.
@@ -13,6 +13,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"golang.org/x/exp/slices"
 
@@ -268,6 +269,43 @@ func TestValidateOperationalOptions(t *testing.T) {
 	}
 }
 
+func TestValidateFutureDate(t *testing.T) {
+	tests := map[string]struct {
+		value     string
+		wantError string
+	}{
+		"today":       {value: time.Now().UTC().Format("2006-01-02")},
+		"future date": {value: time.Now().UTC().AddDate(1, 0, 0).Format("2006-01-02")},
+		"past date": {
+			value:     "2020-01-31",
+			wantError: "invalid value for 'valid-until': '2020-01-31' is in the past",
+		},
+		"invalid day": {
+			value:     "2099-02-30",
+			wantError: "invalid value for 'valid-until': '2099-02-30' is not a valid date in the format YYYY-MM-DD",
+		},
+		"invalid format": {
+			value:     "31/12/2099",
+			wantError: "invalid value for 'valid-until': '31/12/2099' is not a valid date in the format YYYY-MM-DD",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateFutureDate(test.value, "valid-until")
+			if test.wantError == "" {
+				if err != nil {
+					t.Errorf("Got unexpected error: %s", err.Error())
+				}
+				return
+			}
+			if err == nil || err.Error() != test.wantError {
+				t.Errorf("Expected error '%s', got '%v'", test.wantError, err)
+			}
+		})
+	}
+}
+
 func TestValidateFile(t *testing.T) {
 	tempDir := t.TempDir()
 	emptyFile := tempDir + "/empty.txt"
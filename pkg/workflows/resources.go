@@ -0,0 +1,202 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package workflows
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// ServerLimits are the limits of the REANA server that workflow specifications must comply with.
+// Empty values are not checked.
+type ServerLimits struct {
+	MaxCPULimit         string   // Kubernetes CPU quantity, e.g. "4" or "2500m"
+	MaxMemoryLimit      string   // Kubernetes memory quantity, e.g. "16Gi"
+	MaxJobTimeout       string   // in seconds
+	WorkflowEngines     []string // supported values of workflow.type
+	WorkspacesAvailable []string // supported values of workspace.root_path
+	DaskEnabled         *bool    // whether Dask clusters can be requested, nil if unknown
+}
+
+// memoryUnits maps the suffixes of Kubernetes memory quantities to their multipliers.
+var memoryUnits = map[string]float64{
+	"":   1,
+	"k":  1e3,
+	"M":  1e6,
+	"G":  1e9,
+	"T":  1e12,
+	"P":  1e15,
+	"E":  1e18,
+	"Ki": 1 << 10,
+	"Mi": 1 << 20,
+	"Gi": 1 << 30,
+	"Ti": 1 << 40,
+	"Pi": 1 << 50,
+	"Ei": 1 << 60,
+}
+
+// ParseKubernetesMemory parses a Kubernetes memory quantity such as "512Mi", "2G" or "1e9" and returns bytes.
+func ParseKubernetesMemory(quantity string) (float64, error) {
+	quantity = strings.TrimSpace(quantity)
+	numberEnd := strings.IndexFunc(quantity, func(c rune) bool {
+		return (c < '0' || c > '9') && c != '.' && c != 'e' && c != '+' && c != '-'
+	})
+	number, unit := quantity, ""
+	if numberEnd >= 0 {
+		number, unit = quantity[:numberEnd], quantity[numberEnd:]
+	}
+
+	multiplier, validUnit := memoryUnits[unit]
+	value, err := strconv.ParseFloat(number, 64)
+	if !validUnit || err != nil || value < 0 {
+		return 0, fmt.Errorf("'%s' is not a valid memory quantity", quantity)
+	}
+	return value * multiplier, nil
+}
+
+// ParseKubernetesCPU parses a Kubernetes CPU quantity such as "2", "0.5" or "500m" and returns millicores.
+func ParseKubernetesCPU(quantity string) (float64, error) {
+	quantity = strings.TrimSpace(quantity)
+	number, multiplier := quantity, 1000.0
+	if strings.HasSuffix(quantity, "m") {
+		number, multiplier = strings.TrimSuffix(quantity, "m"), 1
+	}
+
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil || value < 0 || math.IsInf(value, 0) || math.IsNaN(value) {
+		return 0, fmt.Errorf("'%s' is not a valid CPU quantity", quantity)
+	}
+	return value * multiplier, nil
+}
+
+// CheckServerLimits compares the workflow type, the workspace and the Kubernetes resources
+// requested by the steps of the specification with the server limits.
+// Returns a description of each violation, sorted by location in the specification.
+func CheckServerLimits(spec map[string]any, limits ServerLimits) []string {
+	var violations []string
+
+	workflowType, err := GetSpecificationType(spec)
+	if err == nil && len(limits.WorkflowEngines) > 0 &&
+		!slices.Contains(limits.WorkflowEngines, workflowType) {
+		violations = append(violations, fmt.Sprintf(
+			"workflow type '%s' is not supported by the server. Supported engines are '%s'",
+			workflowType,
+			strings.Join(limits.WorkflowEngines, "', '"),
+		))
+	}
+
+	if workspace, ok := spec["workspace"].(map[string]any); ok {
+		rootPath, _ := workspace["root_path"].(string)
+		if rootPath != "" && len(limits.WorkspacesAvailable) > 0 &&
+			!slices.Contains(limits.WorkspacesAvailable, rootPath) {
+			violations = append(violations, fmt.Sprintf(
+				"workspace '%s' is not available on the server. Available workspaces are '%s'",
+				rootPath,
+				strings.Join(limits.WorkspacesAvailable, "', '"),
+			))
+		}
+	}
+
+	if RequestsDask(spec) && limits.DaskEnabled != nil && !*limits.DaskEnabled {
+		violations = append(
+			violations,
+			"workflow requests a Dask cluster but Dask is not enabled on the server",
+		)
+	}
+
+	var resourceViolations []string
+	var walk func(node any, location string)
+	walk = func(node any, location string) {
+		switch value := node.(type) {
+		case map[string]any:
+			if name, ok := value["name"].(string); ok && name != "" {
+				location = fmt.Sprintf("step '%s'", name)
+			}
+			resourceViolations = append(
+				resourceViolations,
+				checkStepLimits(value, location, limits)...,
+			)
+			for key, child := range value {
+				walk(child, strings.TrimPrefix(location+"."+key, "."))
+			}
+		case []any:
+			for i, child := range value {
+				walk(child, fmt.Sprintf("%s[%d]", location, i))
+			}
+		}
+	}
+	walk(spec["workflow"], "workflow")
+	sort.Strings(resourceViolations)
+
+	return append(violations, resourceViolations...)
+}
+
+// RequestsDask returns true if the specification requests a Dask cluster in workflow.resources.dask.
+func RequestsDask(spec map[string]any) bool {
+	workflow, _ := spec["workflow"].(map[string]any)
+	resources, _ := workflow["resources"].(map[string]any)
+	_, requested := resources["dask"]
+	return requested
+}
+
+// checkStepLimits checks the Kubernetes resources set directly in the given step against the server limits.
+func checkStepLimits(step map[string]any, location string, limits ServerLimits) []string {
+	var violations []string
+
+	check := func(
+		key, maximum string,
+		parse func(string) (float64, error),
+	) {
+		raw, exists := step[key]
+		if !exists || raw == nil {
+			return
+		}
+		requested := fmt.Sprint(raw)
+		requestedValue, err := parse(requested)
+		if err != nil {
+			violations = append(violations, fmt.Sprintf(
+				"%s: invalid %s, %s",
+				location, key, err.Error(),
+			))
+			return
+		}
+		if maximum == "" {
+			return
+		}
+		maximumValue, err := parse(maximum)
+		if err != nil {
+			return
+		}
+		if requestedValue > maximumValue {
+			violations = append(violations, fmt.Sprintf(
+				"%s: %s '%s' exceeds the maximum allowed by the server '%s'",
+				location, key, requested, maximum,
+			))
+		}
+	}
+
+	check("kubernetes_memory_limit", limits.MaxMemoryLimit, ParseKubernetesMemory)
+	check("kubernetes_cpu_limit", limits.MaxCPULimit, ParseKubernetesCPU)
+	check("kubernetes_job_timeout", limits.MaxJobTimeout, parseJobTimeout)
+	return violations
+}
+
+// parseJobTimeout parses a Kubernetes job timeout in seconds.
+func parseJobTimeout(timeout string) (float64, error) {
+	value, err := strconv.ParseFloat(strings.TrimSpace(timeout), 64)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("'%s' is not a valid number of seconds", timeout)
+	}
+	return value, nil
+}
@@ -0,0 +1,185 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package workflows
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseKubernetesMemory(t *testing.T) {
+	tests := map[string]struct {
+		quantity  string
+		want      float64
+		wantError bool
+	}{
+		"bytes":          {quantity: "1024", want: 1024},
+		"binary unit":    {quantity: "512Mi", want: 512 << 20},
+		"decimal unit":   {quantity: "2G", want: 2e9},
+		"exponent":       {quantity: "1e3", want: 1000},
+		"fraction":       {quantity: "1.5Gi", want: 1.5 * (1 << 30)},
+		"invalid unit":   {quantity: "2GB", wantError: true},
+		"not a quantity": {quantity: "lots", wantError: true},
+		"negative":       {quantity: "-1Gi", wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseKubernetesMemory(test.quantity)
+			if test.wantError {
+				if err == nil {
+					t.Errorf("Expected error, got %f", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Got unexpected error: %s", err.Error())
+			}
+			if got != test.want {
+				t.Errorf("Expected %f, got %f", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseKubernetesCPU(t *testing.T) {
+	tests := map[string]struct {
+		quantity  string
+		want      float64
+		wantError bool
+	}{
+		"cores":       {quantity: "2", want: 2000},
+		"fraction":    {quantity: "0.5", want: 500},
+		"millicores":  {quantity: "250m", want: 250},
+		"invalid":     {quantity: "two", wantError: true},
+		"only suffix": {quantity: "m", wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseKubernetesCPU(test.quantity)
+			if test.wantError {
+				if err == nil {
+					t.Errorf("Expected error, got %f", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Got unexpected error: %s", err.Error())
+			}
+			if got != test.want {
+				t.Errorf("Expected %f, got %f", test.want, got)
+			}
+		})
+	}
+}
+
+func TestCheckServerLimits(t *testing.T) {
+	limits := ServerLimits{
+		MaxCPULimit:         "4",
+		MaxMemoryLimit:      "8Gi",
+		MaxJobTimeout:       "3600",
+		WorkflowEngines:     []string{"serial", "cwl"},
+		WorkspacesAvailable: []string{"/var/reana"},
+	}
+	step := func(name string, resources map[string]any) map[string]any {
+		s := map[string]any{"name": name, "commands": []any{"echo"}}
+		for key, value := range resources {
+			s[key] = value
+		}
+		return s
+	}
+	spec := func(workflowType string, steps ...any) map[string]any {
+		return map[string]any{
+			"workflow": map[string]any{
+				"type":          workflowType,
+				"specification": map[string]any{"steps": steps},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		spec   map[string]any
+		limits ServerLimits
+		want   []string
+	}{
+		"within limits": {
+			spec: spec("serial", step("fit", map[string]any{
+				"kubernetes_cpu_limit":    "3500m",
+				"kubernetes_memory_limit": "8Gi",
+				"kubernetes_job_timeout":  3600,
+			})),
+			limits: limits,
+		},
+		"exceeded limits": {
+			spec: spec("serial",
+				step("gen", map[string]any{"kubernetes_memory_limit": "9G"}),
+				step("fit", map[string]any{
+					"kubernetes_cpu_limit":   5,
+					"kubernetes_job_timeout": "7200",
+				}),
+			),
+			limits: limits,
+			want: []string{
+				"step 'fit': kubernetes_cpu_limit '5' exceeds the maximum allowed by the server '4'",
+				"step 'fit': kubernetes_job_timeout '7200' exceeds the maximum allowed by the server '3600'",
+				"step 'gen': kubernetes_memory_limit '9G' exceeds the maximum allowed by the server '8Gi'",
+			},
+		},
+		"unnamed step with invalid value": {
+			spec: spec("serial",
+				map[string]any{"kubernetes_job_timeout": "soon"},
+			),
+			limits: limits,
+			want: []string{
+				"workflow.specification.steps[0]: invalid kubernetes_job_timeout, 'soon' is not a valid number of seconds",
+			},
+		},
+		"unsupported engine and workspace": {
+			spec: map[string]any{
+				"workspace": map[string]any{"root_path": "/var/other"},
+				"workflow":  map[string]any{"type": "snakemake"},
+			},
+			limits: limits,
+			want: []string{
+				"workflow type 'snakemake' is not supported by the server. Supported engines are 'serial', 'cwl'",
+				"workspace '/var/other' is not available on the server. Available workspaces are '/var/reana'",
+			},
+		},
+		"dask not enabled": {
+			spec: map[string]any{
+				"workflow": map[string]any{
+					"type":      "serial",
+					"resources": map[string]any{"dask": map[string]any{}},
+				},
+			},
+			limits: ServerLimits{DaskEnabled: new(bool)},
+			want: []string{
+				"workflow requests a Dask cluster but Dask is not enabled on the server",
+			},
+		},
+		"no server limits": {
+			spec: spec("snakemake", step("fit", map[string]any{
+				"kubernetes_memory_limit": "100Gi",
+			})),
+			limits: ServerLimits{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CheckServerLimits(test.spec, test.limits)
+			if len(got) != 0 || len(test.want) != 0 {
+				if !reflect.DeepEqual(got, test.want) {
+					t.Errorf("Expected %v, got %v", test.want, got)
+				}
+			}
+		})
+	}
+}
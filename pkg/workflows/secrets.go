@@ -0,0 +1,127 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package workflows
+
+import (
+	"reanahub/reana-client-go/pkg/config"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// SecretReference represents a secret that a workflow specification relies on.
+type SecretReference struct {
+	Name string // name of the secret
+	Type string // "env" or "file", as in the secrets-add command
+	// Guessed is true if the secret is only guessed from an environment variable used in a command,
+	// which may as well be set by the job image or be unset.
+	Guessed bool
+}
+
+// envReferenceRegex matches $NAME and ${NAME} references to upper case environment variables.
+var envReferenceRegex = regexp.MustCompile(`\$\{?([A-Z][A-Z0-9_]*)\}?`)
+
+// Regular expressions matching the shell constructs assigning variables: NAME=value,
+// for NAME in ... and read NAME..., the names being in the first group.
+var (
+	assignmentRegex = regexp.MustCompile(`(?:^|[\s;&|(])([A-Za-z_][A-Za-z0-9_]*)=`)
+	forLoopRegex    = regexp.MustCompile(`\bfor\s+([A-Za-z_][A-Za-z0-9_]*)\s+in\b`)
+	readRegex       = regexp.MustCompile(`\bread(?:\s+-[A-Za-z]+)*((?:\s+[A-Za-z_][A-Za-z0-9_]*)+)`)
+)
+
+// fileReferenceRegex matches paths to file secrets mounted in the job containers.
+var fileReferenceRegex = regexp.MustCompile(
+	regexp.QuoteMeta(config.SecretsMountPath) + `/([A-Za-z0-9_.\-]+)`,
+)
+
+// FindSecretReferences scans the given specification and returns the secrets it refers to, sorted by name.
+// Secrets are detected from paths to mounted file secrets and from the kerberos, voms_proxy
+// and rucio options, which require well-known secrets to be present. Upper case environment
+// variables used in the specification that are neither input parameters nor assigned in the
+// same command are guessed to be secrets too.
+func FindSecretReferences(spec map[string]any) []SecretReference {
+	parameters := getSpecificationParameters(spec)
+	found := make(map[string]SecretReference)
+
+	var walk func(node any)
+	walk = func(node any) {
+		switch value := node.(type) {
+		case map[string]any:
+			for key, child := range value {
+				if enabled, ok := child.(bool); ok && enabled {
+					for _, name := range config.SecretsRequiredByOption[key] {
+						found[name] = SecretReference{Name: name, Type: "env"}
+					}
+				}
+				walk(child)
+			}
+		case []any:
+			for _, child := range value {
+				walk(child)
+			}
+		case string:
+			for _, match := range fileReferenceRegex.FindAllStringSubmatch(value, -1) {
+				found[match[1]] = SecretReference{Name: match[1], Type: "file"}
+			}
+			assigned := assignedVariables(value)
+			for _, match := range envReferenceRegex.FindAllStringSubmatch(value, -1) {
+				name := match[1]
+				if slices.Contains(parameters, name) ||
+					slices.Contains(assigned, name) ||
+					slices.Contains(config.CommonEnvironmentVariables, name) {
+					continue
+				}
+				if _, exists := found[name]; !exists {
+					found[name] = SecretReference{Name: name, Type: "env", Guessed: true}
+				}
+			}
+		}
+	}
+	walk(spec)
+
+	references := make([]SecretReference, 0, len(found))
+	for _, ref := range found {
+		references = append(references, ref)
+	}
+	sort.Slice(references, func(i, j int) bool {
+		return references[i].Name < references[j].Name
+	})
+	return references
+}
+
+// assignedVariables returns the names of the shell variables assigned in the command,
+// which are local to it rather than secrets.
+func assignedVariables(command string) []string {
+	var names []string
+	for _, regex := range []*regexp.Regexp{assignmentRegex, forLoopRegex} {
+		for _, match := range regex.FindAllStringSubmatch(command, -1) {
+			names = append(names, match[1])
+		}
+	}
+	for _, match := range readRegex.FindAllStringSubmatch(command, -1) {
+		names = append(names, strings.Fields(match[1])...)
+	}
+	return names
+}
+
+// MissingSecrets returns the references whose names are not part of the given secret names.
+func MissingSecrets(
+	references []SecretReference,
+	secretNames []string,
+) []SecretReference {
+	var missing []SecretReference
+	for _, ref := range references {
+		if !slices.Contains(secretNames, ref.Name) {
+			missing = append(missing, ref)
+		}
+	}
+	return missing
+}
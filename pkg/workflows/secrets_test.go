@@ -0,0 +1,112 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package workflows
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindSecretReferences(t *testing.T) {
+	tests := map[string]struct {
+		spec map[string]any
+		want []SecretReference
+	}{
+		"no references": {
+			spec: map[string]any{
+				"workflow": map[string]any{
+					"type":     "serial",
+					"commands": []any{"echo hello"},
+				},
+			},
+			want: []SecretReference{},
+		},
+		"environment variables": {
+			spec: map[string]any{
+				"workflow": map[string]any{
+					"commands": []any{"echo $TOKEN ${OTHER_TOKEN} $HOME $ROOTSYS $LD_LIBRARY_PATH"},
+				},
+			},
+			want: []SecretReference{
+				{Name: "OTHER_TOKEN", Type: "env", Guessed: true},
+				{Name: "TOKEN", Type: "env", Guessed: true},
+			},
+		},
+		"shell variables are ignored": {
+			spec: map[string]any{
+				"workflow": map[string]any{
+					"commands": []any{
+						"for F in $FILES; do root -b -q \"$F\"; done",
+						"N=3; export OUT=x; echo $N ${OUT}",
+						"cat list.txt | while read -r LINE COUNT; do echo $LINE $COUNT; done",
+						"echo $N",
+					},
+				},
+			},
+			want: []SecretReference{
+				{Name: "FILES", Type: "env", Guessed: true},
+				{Name: "N", Type: "env", Guessed: true},
+			},
+		},
+		"parameters are ignored": {
+			spec: map[string]any{
+				"inputs": map[string]any{
+					"parameters": map[string]any{"EVENTS": 10},
+				},
+				"workflow": map[string]any{
+					"commands": []any{"run ${EVENTS} ${data}"},
+				},
+			},
+			want: []SecretReference{},
+		},
+		"mounted files": {
+			spec: map[string]any{
+				"workflow": map[string]any{
+					"commands": []any{"cp /etc/reana/secrets/my.key ."},
+				},
+			},
+			want: []SecretReference{{Name: "my.key", Type: "file"}},
+		},
+		"options requiring secrets": {
+			spec: map[string]any{
+				"workflow": map[string]any{
+					"steps": []any{
+						map[string]any{"kerberos": true},
+						map[string]any{"rucio": false},
+					},
+				},
+			},
+			want: []SecretReference{
+				{Name: "CERN_KEYTAB", Type: "env"},
+				{Name: "CERN_USER", Type: "env"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := FindSecretReferences(test.spec)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestMissingSecrets(t *testing.T) {
+	references := []SecretReference{
+		{Name: "TOKEN", Type: "env"},
+		{Name: "my.key", Type: "file"},
+	}
+	got := MissingSecrets(references, []string{"my.key", "unused"})
+	want := []SecretReference{{Name: "TOKEN", Type: "env"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
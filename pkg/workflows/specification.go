@@ -0,0 +1,84 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package workflows
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// LoadSpecification reads a local REANA specification file (e.g. reana.yaml) and returns its content.
+func LoadSpecification(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"specification file %s could not be read: %s",
+			path, err.Error(),
+		)
+	}
+
+	var spec map[string]any
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf(
+			"specification file %s is not valid YAML: %s",
+			path, err.Error(),
+		)
+	}
+	if spec == nil {
+		return nil, fmt.Errorf("specification file %s is empty", path)
+	}
+	return spec, nil
+}
+
+// SpecificationToMap converts a specification returned by the server to a generic map,
+// so that it can be inspected in the same way as a local specification file.
+func SpecificationToMap(specification any) (map[string]any, error) {
+	specJson, err := json.Marshal(specification)
+	if err != nil {
+		return nil, err
+	}
+	var spec map[string]any
+	if err := json.Unmarshal(specJson, &spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// GetSpecificationType returns the workflow type (engine) declared in the specification.
+func GetSpecificationType(spec map[string]any) (string, error) {
+	workflow, ok := spec["workflow"].(map[string]any)
+	if !ok {
+		return "", fmt.Errorf("specification does not contain a 'workflow' section")
+	}
+	workflowType, ok := workflow["type"].(string)
+	if !ok || workflowType == "" {
+		return "", fmt.Errorf("specification does not declare a 'workflow.type'")
+	}
+	return workflowType, nil
+}
+
+// getSpecificationParameters returns the names of the input parameters declared in the specification.
+func getSpecificationParameters(spec map[string]any) []string {
+	var names []string
+	inputs, ok := spec["inputs"].(map[string]any)
+	if !ok {
+		return names
+	}
+	parameters, ok := inputs["parameters"].(map[string]any)
+	if !ok {
+		return names
+	}
+	for name := range parameters {
+		names = append(names, name)
+	}
+	return names
+}
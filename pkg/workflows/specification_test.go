@@ -0,0 +1,67 @@
+/*
+This file is part of REANA.
+Copyright (C) 2026 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package workflows
+
+import (
+	"os"
+	"testing"
+)
+
+func TestLoadSpecification(t *testing.T) {
+	tempDir := t.TempDir()
+	tests := map[string]struct {
+		content   string
+		wantType  string
+		wantError bool
+	}{
+		"valid":           {content: "workflow:\n  type: serial\n", wantType: "serial"},
+		"invalid yaml":    {content: "workflow: [", wantError: true},
+		"empty":           {content: "", wantError: true},
+		"missing type":    {content: "workflow:\n  file: a.yaml\n", wantType: ""},
+		"missing section": {content: "version: 0.9.0\n", wantType: ""},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := tempDir + "/" + name + ".yaml"
+			if err := os.WriteFile(path, []byte(test.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			spec, err := LoadSpecification(path)
+			if test.wantError {
+				if err == nil {
+					t.Errorf("Expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			workflowType, err := GetSpecificationType(spec)
+			if test.wantType == "" && err == nil {
+				t.Errorf("Expected error, got type %s", workflowType)
+			}
+			if workflowType != test.wantType {
+				t.Errorf("Expected %s, got %s", test.wantType, workflowType)
+			}
+		})
+	}
+}
+
+func TestSpecificationToMap(t *testing.T) {
+	spec, err := SpecificationToMap(struct {
+		Version string `json:"version"`
+	}{Version: "0.9.0"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if spec["version"] != "0.9.0" {
+		t.Errorf("Expected version 0.9.0, got %v", spec["version"])
+	}
+}
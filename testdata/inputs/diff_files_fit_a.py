@@ -0,0 +1,4 @@
+import fit
+
+x = 1
+fit.run(x)
@@ -0,0 +1,4 @@
+import fit
+
+x = 2
+fit.run(x)